@@ -0,0 +1,390 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package backfill exports the SES events of a past range, e.g. the history
+// of a new tenant. The range is split into chunks exported in parallel, and
+// the chunks acknowledged by the output are kept in a state file so an
+// interrupted backfill resumes where it stopped.
+package backfill
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+const (
+	// progressPeriod is how often the progress is logged.
+	progressPeriod = 30 * time.Second
+
+	// DefaultRateLimit is the number of SES API requests per second of a
+	// backfill when neither it nor the tenant sets one, so the workers do
+	// not exhaust the API quota of the collection.
+	DefaultRateLimit = 5
+)
+
+// errStopped interrupts the export of a chunk on Stop.
+var errStopped = errors.New("backfill stopped")
+
+// Config is the range to backfill and how to split it.
+type Config struct {
+	Types []client.EventType
+	From  time.Time
+	To    time.Time
+	// Chunk is the duration of the time windows exported by a request. The
+	// windows are aligned on multiples of Chunk, so the chunks are the same
+	// when the backfill is resumed. The first and the last ones are clipped
+	// to the range.
+	Chunk time.Duration
+	// Workers is the number of chunks exported in parallel.
+	Workers   int
+	BatchSize int
+}
+
+// Validate checks the range and the parallelism.
+func (c *Config) Validate() error {
+	if c.Chunk <= 0 {
+		return fmt.Errorf("chunk must be positive, got %v", c.Chunk)
+	}
+	if c.Workers < 1 {
+		return fmt.Errorf("workers must be at least 1, got %d", c.Workers)
+	}
+	if c.BatchSize < 1 || c.BatchSize > client.MaxBatchSize {
+		return fmt.Errorf("batch_size must be between 1 and %d, got %d", client.MaxBatchSize, c.BatchSize)
+	}
+	if !c.From.Before(c.To) {
+		return fmt.Errorf("from %s must be before to %s", c.From.Format(time.RFC3339), c.To.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// chunk is the export of an event type over a time window.
+type chunk struct {
+	t     client.EventType
+	start time.Time
+	end   time.Time
+}
+
+// key identifies the chunk in the state file.
+func (c chunk) key() string {
+	return fmt.Sprintf("%s/%s/%s", c.t.Name(), c.start.Format(time.RFC3339), c.end.Format(time.RFC3339))
+}
+
+// chunks splits the range of every event type into windows of Chunk.
+func (c *Config) chunks() []chunk {
+	var chunks []chunk
+	for aligned := c.From.Truncate(c.Chunk); aligned.Before(c.To); aligned = aligned.Add(c.Chunk) {
+		start, end := aligned, aligned.Add(c.Chunk)
+		if start.Before(c.From) {
+			start = c.From
+		}
+		if end.After(c.To) {
+			end = c.To
+		}
+		for _, t := range c.Types {
+			chunks = append(chunks, chunk{t: t, start: start, end: end})
+		}
+	}
+	return chunks
+}
+
+// chunkState tracks the events of a chunk until they are all acknowledged.
+type chunkState struct {
+	chunk
+	events    int
+	pending   int
+	published bool
+	failed    bool
+}
+
+// Backfill exports the chunks of the range not done yet.
+type Backfill struct {
+	config   Config
+	smClient client.SymantecClient
+	store    *registry.Store
+	build    client.EventBuilder
+	done     chan struct{}
+	logger   *logp.Logger
+
+	mutex sync.Mutex
+	// publishers are closed by Stop, so the workers blocked publishing
+	// return.
+	publishers []beat.Client
+	// resolved is done once per chunk, when it is acknowledged or failed.
+	resolved sync.WaitGroup
+	total    int
+	skipped  int
+	acked    int
+	failed   int
+	events   int
+	started  time.Time
+}
+
+// New creates a Backfill keeping the chunks done in store.
+func New(config Config, smClient client.SymantecClient, store *registry.Store, build client.EventBuilder) *Backfill {
+	return &Backfill{
+		config:   config,
+		smClient: smClient,
+		store:    store,
+		build:    build,
+		done:     make(chan struct{}),
+		logger:   logp.NewLogger("backfill"),
+	}
+}
+
+// Run exports the chunks not done yet, publishes their events with
+// processing and waits for the output to acknowledge them, or for Stop. It
+// returns an error when chunks failed, which are exported again by the next
+// run.
+func (b *Backfill) Run(pipeline beat.PipelineConnector, processing beat.ProcessingConfig) error {
+	chunks := make(chan *chunkState)
+	var todo []*chunkState
+	for _, c := range b.config.chunks() {
+		b.total++
+		if ok, err := b.store.Get(c.key(), new(int)); err != nil {
+			return err
+		} else if ok {
+			b.skipped++
+			continue
+		}
+		todo = append(todo, &chunkState{chunk: c})
+	}
+	b.logger.Infof("Backfilling %d chunks of %v from %s to %s, %d already done",
+		len(todo), b.config.Chunk, b.config.From.Format(time.RFC3339), b.config.To.Format(time.RFC3339), b.skipped)
+	b.started = time.Now()
+
+	// The publishers are closed once the chunks are acknowledged, the
+	// pipeline does not report the acknowledgements of closed clients.
+	defer b.closePublishers()
+	publishers, err := b.connect(pipeline, processing)
+	if err != nil || publishers == nil {
+		return err
+	}
+
+	var workers sync.WaitGroup
+	for _, publisher := range publishers {
+		workers.Add(1)
+		go func(publisher beat.Client) {
+			defer workers.Done()
+			b.work(publisher, chunks)
+		}(publisher)
+	}
+
+	go b.report()
+dispatch:
+	for _, state := range todo {
+		b.resolved.Add(1)
+		select {
+		case chunks <- state:
+		case <-b.done:
+			b.resolved.Done()
+			break dispatch
+		}
+	}
+	close(chunks)
+
+	resolved := make(chan struct{})
+	go func() {
+		b.resolved.Wait()
+		close(resolved)
+	}()
+	select {
+	case <-resolved:
+	case <-b.done:
+	}
+	workers.Wait()
+
+	b.logProgress()
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.failed > 0 {
+		return fmt.Errorf("%d chunks failed, run the backfill again to retry them", b.failed)
+	}
+	if b.skipped+b.acked < b.total {
+		b.logger.Info("Backfill stopped, run it again to resume it")
+	}
+	return nil
+}
+
+// connect connects a publisher per worker. It returns none when the backfill
+// is already stopped.
+func (b *Backfill) connect(pipeline beat.PipelineConnector, processing beat.ProcessingConfig) ([]beat.Client, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	select {
+	case <-b.done:
+		return nil, nil
+	default:
+	}
+	for i := 0; i < b.config.Workers; i++ {
+		publisher, err := pipeline.ConnectWith(beat.ClientConfig{
+			Processing: processing,
+			ACKEvents:  b.ack,
+		})
+		if err != nil {
+			return nil, err
+		}
+		b.publishers = append(b.publishers, publisher)
+	}
+	return b.publishers, nil
+}
+
+func (b *Backfill) closePublishers() {
+	b.mutex.Lock()
+	publishers := b.publishers
+	b.mutex.Unlock()
+	for _, publisher := range publishers {
+		publisher.Close()
+	}
+}
+
+// Stop interrupts the backfill. The publishers are closed right away, so the
+// events waiting for the output are dropped, and the chunks not acknowledged
+// yet are exported again by the next run.
+func (b *Backfill) Stop() {
+	b.mutex.Lock()
+	close(b.done)
+	b.mutex.Unlock()
+	b.closePublishers()
+}
+
+// work exports the chunks received on chunks. Every worker acquires its own
+// token and shares the rate limit of the client.
+func (b *Backfill) work(publisher beat.Client, chunks <-chan *chunkState) {
+	smClient := b.smClient
+	if err := smClient.GetOauthToken(); err != nil {
+		b.logger.Errorf("Error getting the access token, check the credentials err=%s", err.Error())
+		for state := range chunks {
+			b.finish(state, err)
+		}
+		return
+	}
+
+	for state := range chunks {
+		err := smClient.Export(state.start, state.end, state.t, b.config.BatchSize, func(mapStr common.MapStr) error {
+			select {
+			case <-b.done:
+				return errStopped
+			default:
+			}
+			event := b.build(state.t, mapStr)
+			event.Private = state
+			b.mutex.Lock()
+			state.events++
+			state.pending++
+			b.mutex.Unlock()
+			publisher.Publish(event)
+			return nil
+		})
+		b.finish(state, err)
+	}
+}
+
+// finish records that the events of the chunk are all published, or that
+// its export failed.
+func (b *Backfill) finish(state *chunkState, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err != nil {
+		if err != errStopped {
+			b.logger.Errorf("Error backfilling %s err=%s", state.key(), err.Error())
+			b.failed++
+		}
+		state.failed = true
+		b.resolved.Done()
+		return
+	}
+	state.published = true
+	if state.pending == 0 {
+		b.markDone(state)
+	}
+}
+
+// ack is called by the pipeline with the chunks of the acknowledged events.
+func (b *Backfill) ack(data []interface{}) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, private := range data {
+		state, ok := private.(*chunkState)
+		if !ok {
+			continue
+		}
+		state.pending--
+		if state.published && state.pending == 0 && !state.failed {
+			b.markDone(state)
+		}
+	}
+}
+
+// markDone keeps an acknowledged chunk in the state file. It is called with
+// the mutex held.
+func (b *Backfill) markDone(state *chunkState) {
+	b.acked++
+	b.events += state.events
+	if err := b.store.Set(state.key(), state.events); err != nil {
+		b.logger.Errorf("Error storing the backfill progress err=%s", err.Error())
+	} else if err := b.store.Save(); err != nil {
+		b.logger.Errorf("Error saving the backfill progress err=%s", err.Error())
+	}
+	b.resolved.Done()
+}
+
+// report logs the progress every progressPeriod until the backfill stops.
+func (b *Backfill) report() {
+	ticker := time.NewTicker(progressPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-ticker.C:
+			if b.logProgress() {
+				return
+			}
+		}
+	}
+}
+
+// logProgress logs the chunks done and the estimated time left. It tells
+// whether the backfill is over.
+func (b *Backfill) logProgress() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	done := b.skipped + b.acked
+	left := b.total - done - b.failed
+	elapsed := time.Since(b.started)
+	eta := "unknown"
+	if b.acked > 0 {
+		eta = (elapsed / time.Duration(b.acked) * time.Duration(left)).Round(time.Second).String()
+	}
+	b.logger.Infof("Backfill progress: %d/%d chunks done, %d failed, %d events published in %v, ETA %s",
+		done, b.total, b.failed, b.events, elapsed.Round(time.Second), eta)
+	return left == 0
+}
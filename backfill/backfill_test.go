@@ -0,0 +1,295 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package backfill
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// pipeline acknowledges the events as they are published, unless noACK is
+// set. When block is set, Publish blocks until the publisher is closed.
+type pipeline struct {
+	mutex  sync.Mutex
+	events []beat.Event
+	noACK  bool
+	block  bool
+}
+
+func (p *pipeline) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func (p *pipeline) ConnectWith(config beat.ClientConfig) (beat.Client, error) {
+	return &publisher{pipeline: p, ack: config.ACKEvents, closed: make(chan struct{})}, nil
+}
+
+type publisher struct {
+	pipeline  *pipeline
+	ack       func([]interface{})
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (p *publisher) Publish(event beat.Event) {
+	if p.pipeline.block {
+		<-p.closed
+		return
+	}
+	p.pipeline.mutex.Lock()
+	p.pipeline.events = append(p.pipeline.events, event)
+	p.pipeline.mutex.Unlock()
+	if !p.pipeline.noACK {
+		p.ack([]interface{}{event.Private})
+	}
+}
+
+func (p *publisher) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		p.Publish(event)
+	}
+}
+
+func (p *publisher) Close() error {
+	p.closeOnce.Do(func() { close(p.closed) })
+	return nil
+}
+
+// sesServer returns one event for the first page of every export request
+// and fails the exports of the types in failing.
+func sesServer(failing map[string]bool) (*httptest.Server, func() int) {
+	var mutex sync.Mutex
+	pages := map[string]int{}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokens":
+			fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+		case "/sccs/v1/events/export":
+			var request struct {
+				Type      string `json:"type"`
+				StartDate string `json:"startDate"`
+			}
+			json.NewDecoder(r.Body).Decode(&request)
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests++
+			if failing[request.Type] {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			key := request.Type + request.StartDate
+			pages[key]++
+			if pages[key] > 1 {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprintf(w, `[{"type_id":8031,"start":"%s"}]`, request.StartDate)
+		}
+	}))
+	return server, func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return requests
+	}
+}
+
+func build(t client.EventType, fields common.MapStr) beat.Event {
+	return beat.Event{Fields: fields}
+}
+
+func newStore(t *testing.T) (*registry.Store, string, func()) {
+	dir, err := ioutil.TempDir("", "backfill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "backfill.json")
+	store, err := registry.OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, path, func() { os.RemoveAll(dir) }
+}
+
+var from = time.Date(2026, 10, 1, 10, 30, 0, 0, time.UTC)
+
+func newConfig() Config {
+	return Config{
+		Types:     []client.EventType{client.FIREWALL, client.MALWARE_PROTECTION},
+		From:      from,
+		To:        from.Add(3 * time.Hour),
+		Chunk:     time.Hour,
+		Workers:   3,
+		BatchSize: 100,
+	}
+}
+
+func TestChunks(t *testing.T) {
+	a := assert.New(t)
+
+	config := newConfig()
+	config.Types = []client.EventType{client.FIREWALL}
+	chunks := config.chunks()
+
+	// The chunks are aligned on the hour, the first one starts and the last
+	// one ends with the range.
+	if a.Len(chunks, 4) {
+		a.Equal("firewall/2026-10-01T10:30:00Z/2026-10-01T11:00:00Z", chunks[0].key())
+		a.Equal("firewall/2026-10-01T12:00:00Z/2026-10-01T13:00:00Z", chunks[2].key())
+		a.Equal("firewall/2026-10-01T13:00:00Z/2026-10-01T13:30:00Z", chunks[3].key())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for name, update := range map[string]func(*Config){
+		"chunk":      func(c *Config) { c.Chunk = 0 },
+		"workers":    func(c *Config) { c.Workers = 0 },
+		"batch_size": func(c *Config) { c.BatchSize = client.MaxBatchSize + 1 },
+		"from":       func(c *Config) { c.To = c.From },
+	} {
+		config := newConfig()
+		update(&config)
+		err := config.Validate()
+		if assert.Error(t, err, name) {
+			assert.Contains(t, err.Error(), name)
+		}
+	}
+	config := newConfig()
+	assert.NoError(t, config.Validate())
+}
+
+func TestRun(t *testing.T) {
+	a := assert.New(t)
+
+	server, requests := sesServer(nil)
+	defer server.Close()
+	store, path, remove := newStore(t)
+	defer remove()
+
+	sm := client.NewSymantecClient(server.URL, "customer", "domain", "id", "secret")
+	p := &pipeline{}
+	a.NoError(New(newConfig(), sm, store, build).Run(p, beat.ProcessingConfig{}))
+
+	// 4 windows of 2 types, each of one page and the empty one ending it.
+	a.Equal(16, requests())
+	a.Len(p.events, 8)
+	saved, err := registry.OpenFile(path)
+	a.NoError(err)
+	a.Len(saved.Keys(), 8)
+
+	// Nothing is left to export when the backfill is resumed.
+	a.NoError(New(newConfig(), sm, saved, build).Run(p, beat.ProcessingConfig{}))
+	a.Equal(16, requests())
+}
+
+func TestRunFailedChunks(t *testing.T) {
+	a := assert.New(t)
+
+	failing, _ := sesServer(map[string]bool{"FIREWALL": true})
+	defer failing.Close()
+	store, _, remove := newStore(t)
+	defer remove()
+
+	sm := client.NewSymantecClient(failing.URL, "customer", "domain", "id", "secret")
+	err := New(newConfig(), sm, store, build).Run(&pipeline{}, beat.ProcessingConfig{})
+	if a.Error(err) {
+		a.Contains(err.Error(), "4 chunks failed")
+	}
+	a.Len(store.Keys(), 4)
+	for _, key := range store.Keys() {
+		a.Contains(key, "malware_protection/")
+	}
+
+	// The next run exports the failed chunks only.
+	server, requests := sesServer(nil)
+	defer server.Close()
+	sm = client.NewSymantecClient(server.URL, "customer", "domain", "id", "secret")
+	a.NoError(New(newConfig(), sm, store, build).Run(&pipeline{}, beat.ProcessingConfig{}))
+	a.Equal(8, requests())
+	a.Len(store.Keys(), 8)
+}
+
+func TestRunWaitsForACK(t *testing.T) {
+	a := assert.New(t)
+
+	server, _ := sesServer(nil)
+	defer server.Close()
+	store, _, remove := newStore(t)
+	defer remove()
+
+	sm := client.NewSymantecClient(server.URL, "customer", "domain", "id", "secret")
+	p := &pipeline{noACK: true}
+	b := New(newConfig(), sm, store, build)
+	result := make(chan error)
+	go func() {
+		result <- b.Run(p, beat.ProcessingConfig{})
+	}()
+
+	select {
+	case err := <-result:
+		t.Fatalf("backfill returned before the events were acknowledged err=%v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	b.Stop()
+	a.NoError(<-result)
+	a.Empty(store.Keys())
+}
+
+func TestStopUnblocksPublish(t *testing.T) {
+	a := assert.New(t)
+
+	server, _ := sesServer(nil)
+	defer server.Close()
+	store, _, remove := newStore(t)
+	defer remove()
+
+	sm := client.NewSymantecClient(server.URL, "customer", "domain", "id", "secret")
+	b := New(newConfig(), sm, store, build)
+	result := make(chan error)
+	go func() {
+		result <- b.Run(&pipeline{block: true}, beat.ProcessingConfig{})
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	b.Stop()
+	select {
+	case err := <-result:
+		a.NoError(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("backfill still blocked publishing after Stop")
+	}
+	a.Empty(store.Keys())
+}
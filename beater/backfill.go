@@ -0,0 +1,124 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/backfill"
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/config"
+	"github.com/marian-craciunescu/symantecbeat/index"
+	"github.com/marian-craciunescu/symantecbeat/inventory"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// BackfillSettings are the settings of `symantecbeat backfill`.
+type BackfillSettings struct {
+	Backfill backfill.Config
+	// Tenant selects the tenant to backfill, the credentials of the beat
+	// when empty.
+	Tenant string
+	// RateLimit overrides the rate limit of the tenant when positive.
+	RateLimit float64
+	// StateFile keeps the chunks done. It defaults to the backfill registry
+	// of the tenant.
+	StateFile string
+}
+
+// Backfill is the beater of `symantecbeat backfill`. It publishes the events
+// of a past range like the ses_events input and exits.
+type Backfill struct {
+	bt         *Symantecbeat
+	backfill   *backfill.Backfill
+	processing beat.ProcessingConfig
+}
+
+// NewBackfill creates the beater backfilling the range of settings.
+func NewBackfill(b *beat.Beat, cfg *common.Config, settings BackfillSettings) (beat.Beater, error) {
+	c, err := config.Unpack(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file: %v", err)
+	}
+	if settings.Backfill.BatchSize == 0 {
+		settings.Backfill.BatchSize = c.BatchSize
+	}
+	if err := settings.Backfill.Validate(); err != nil {
+		return nil, err
+	}
+
+	sm, err := c.ClientOf(settings.Tenant)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file: %v", err)
+	}
+	switch {
+	case settings.RateLimit > 0:
+		sm.Limiter = client.NewLimiter(settings.RateLimit)
+	case sm.Limiter == nil:
+		sm.Limiter = client.NewLimiter(backfill.DefaultRateLimit)
+	}
+
+	var processing beat.ProcessingConfig
+	name := "backfill"
+	if t, ok := c.Tenant(settings.Tenant); ok {
+		processing = beat.ProcessingConfig{
+			Fields:        common.MapStr{"organization": common.MapStr{"id": t.ID}},
+			EventMetadata: common.EventMetadata{Tags: t.Tags},
+		}
+		name = t.ID + "/" + name
+	}
+	var store *registry.Store
+	if settings.StateFile != "" {
+		store, err = registry.OpenFile(settings.StateFile)
+	} else {
+		store, err = registry.Open(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bt := &Symantecbeat{
+		config:  c,
+		router:  index.NewRouter(c.Index),
+		version: b.Info.Version,
+	}
+	return &Backfill{
+		bt:         bt,
+		backfill:   backfill.New(settings.Backfill, sm, store, bt.eventBuilder(inventory.NewCache())),
+		processing: processing,
+	}, nil
+}
+
+// Run backfills the range.
+func (bf *Backfill) Run(b *beat.Beat) error {
+	if err := bf.bt.loadPipelines(b); err != nil {
+		return err
+	}
+	if err := bf.bt.loadTemplates(b); err != nil {
+		return err
+	}
+	return bf.backfill.Run(b.Publisher, bf.processing)
+}
+
+// Stop interrupts the backfill, which resumes on the next run.
+func (bf *Backfill) Stop() {
+	bf.backfill.Stop()
+}
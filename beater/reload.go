@@ -0,0 +1,222 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/cfgfile"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/config"
+	"github.com/marian-craciunescu/symantecbeat/input"
+)
+
+// handoverTimeout is how long an entry waits for the entry using the same
+// input id to be stopped, which happens right away when the entry is the
+// one it replaces.
+var handoverTimeout = 5 * time.Second
+
+// runnerFactory creates the runners of the entries of the config.inputs
+// files. An entry is an input collected with the SES credentials of the beat,
+// or a tenant when its type is tenant.
+type runnerFactory struct {
+	bt *Symantecbeat
+
+	mutex sync.Mutex
+	// owners is the entry running the input of every id.
+	owners map[string]*runnerGroup
+}
+
+// Create creates the runners of an entry. Its inputs keep their checkpoints
+// when the entry is changed, since their registries are named by their ids.
+func (f *runnerFactory) Create(p beat.Pipeline, cfg *common.Config, meta *common.MapStrPointer) (cfgfile.Runner, error) {
+	runners, err := f.newRunners(cfg)
+	if err != nil {
+		return nil, err
+	}
+	g := &runnerGroup{
+		factory:  f,
+		pipeline: p,
+		runners:  runners,
+		stopping: make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	if err := f.claim(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// CheckConfig checks an entry when the files are not reloaded. Unlike Create,
+// it does not claim the ids of its inputs, which Create claims again once the
+// files are loaded.
+func (f *runnerFactory) CheckConfig(cfg *common.Config) error {
+	_, err := f.newRunners(cfg)
+	return err
+}
+
+// newRunners creates the runners of an entry, rejecting the ids used by the
+// inputs of the configuration file.
+func (f *runnerFactory) newRunners(cfg *common.Config) ([]*input.Runner, error) {
+	var entry struct {
+		Type string `config:"type"`
+	}
+	if err := cfg.Unpack(&entry); err != nil {
+		return nil, err
+	}
+	if err := config.CheckEntryKeys(cfg); err != nil {
+		return nil, err
+	}
+
+	var runners []*input.Runner
+	if entry.Type == config.TenantType {
+		var t config.TenantConfig
+		if err := cfg.Unpack(&t); err != nil {
+			return nil, err
+		}
+		tenantRunners, err := f.bt.tenantRunners(t)
+		if err != nil {
+			return nil, err
+		}
+		runners = tenantRunners
+	} else {
+		inputRunners, err := f.bt.newRunners([]*common.Config{cfg}, f.bt.ctx)
+		if err != nil {
+			return nil, err
+		}
+		runners = inputRunners
+	}
+
+	for _, runner := range runners {
+		if f.bt.hasRunner(runner.ID()) {
+			return nil, fmt.Errorf("id %s is already used by an input of the configuration file", runner.ID())
+		}
+	}
+	return runners, nil
+}
+
+// claim makes g the owner of the ids of its inputs. An id owned by another
+// entry is handed over once that entry is stopping, as the entry replaced by
+// g is. It is rejected when the other entry keeps running, e.g. when it is in
+// another file.
+func (f *runnerFactory) claim(g *runnerGroup) error {
+	previous := map[*runnerGroup]string{}
+	f.mutex.Lock()
+	for _, runner := range g.runners {
+		if owner, ok := f.owners[runner.ID()]; ok {
+			previous[owner] = runner.ID()
+		}
+	}
+	f.mutex.Unlock()
+
+	timeout := time.NewTimer(handoverTimeout)
+	defer timeout.Stop()
+	for owner, id := range previous {
+		select {
+		case <-owner.stopping:
+		case <-timeout.C:
+			return fmt.Errorf("id %s is already used by the input of another entry", id)
+		}
+		g.previous = append(g.previous, owner)
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.owners == nil {
+		f.owners = map[string]*runnerGroup{}
+	}
+	for _, runner := range g.runners {
+		f.owners[runner.ID()] = g
+	}
+	return nil
+}
+
+// release hands the ids still owned by g back.
+func (f *runnerFactory) release(g *runnerGroup) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for id, owner := range f.owners {
+		if owner == g {
+			delete(f.owners, id)
+		}
+	}
+}
+
+// runnerGroup runs the inputs of an entry of the config.inputs files. The
+// runners are stopped in the background on a reload, so the inputs are only
+// started once the entries that used their ids before are stopped, and their
+// checkpoints saved.
+type runnerGroup struct {
+	factory  *runnerFactory
+	pipeline beat.PipelineConnector
+	runners  []*input.Runner
+	// previous are the entries that used the ids of the inputs before.
+	previous []*runnerGroup
+
+	stopOnce sync.Once
+	stopping chan struct{}
+	stopped  chan struct{}
+	wg       sync.WaitGroup
+	started  []*input.Runner
+}
+
+func (g *runnerGroup) Start() {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		for _, previous := range g.previous {
+			select {
+			case <-previous.stopped:
+			case <-g.stopping:
+				return
+			}
+		}
+		for _, runner := range g.runners {
+			if err := runner.Start(g.pipeline); err != nil {
+				logp.Err("Error starting input %s: %v", runner.ID(), err)
+				continue
+			}
+			g.started = append(g.started, runner)
+		}
+	}()
+}
+
+func (g *runnerGroup) Stop() {
+	g.stopOnce.Do(func() {
+		close(g.stopping)
+		g.wg.Wait()
+		for _, runner := range g.started {
+			runner.Stop()
+		}
+		g.factory.release(g)
+		close(g.stopped)
+	})
+}
+func (g *runnerGroup) String() string {
+	ids := make([]string, len(g.runners))
+	for i, runner := range g.runners {
+		ids[i] = runner.ID()
+	}
+	return "inputs [" + strings.Join(ids, ", ") + "]"
+}
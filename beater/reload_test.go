@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/cfgfile"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/config"
+	"github.com/marian-craciunescu/symantecbeat/index"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	_ "github.com/marian-craciunescu/symantecbeat/ses"
+)
+
+func TestRunnerFactory(t *testing.T) {
+	a := assert.New(t)
+
+	bt := &Symantecbeat{
+		config: config.DefaultConfig,
+		router: index.NewRouter(config.DefaultConfig.Index),
+	}
+	factory := &runnerFactory{bt: bt}
+
+	reloaded := common.MustNewConfigFrom(common.MapStr{"type": "ses_events", "id": "reloaded"})
+	// Without reload, the entries are checked before they are created.
+	a.NoError(factory.CheckConfig(reloaded))
+	first, err := factory.Create(nil, reloaded, nil)
+	a.NoError(err)
+	a.Equal("inputs [reloaded]", first.String())
+
+	runner, err := factory.Create(nil, common.MustNewConfigFrom(common.MapStr{
+		"type":          config.TenantType,
+		"id":            "acme",
+		"customer_id":   "customer",
+		"domain_id":     "domain",
+		"client_id":     "id",
+		"client_secret": "secret",
+	}), nil)
+	a.NoError(err)
+	a.Equal("inputs [acme/ses_events]", runner.String())
+
+	_, err = factory.Create(nil, common.MustNewConfigFrom(common.MapStr{
+		"type": config.TenantType,
+		"id":   "acme",
+	}), nil)
+	a.Error(err)
+	_, err = factory.Create(nil, common.MustNewConfigFrom(common.MapStr{
+		"type":       "ses_events",
+		"id":         "typo",
+		"batch_sise": 100,
+	}), nil)
+	if a.Error(err) {
+		a.Contains(err.Error(), "did you mean 'batch_size'")
+	}
+
+	// The id of a running entry cannot be used by another one, but is handed
+	// over to the entry replacing it.
+	handoverTimeout = 10 * time.Millisecond
+	_, err = factory.Create(nil, reloaded, nil)
+	a.Error(err)
+	go first.Stop()
+	handoverTimeout = time.Minute
+	replacement, err := factory.Create(nil, reloaded, nil)
+	a.NoError(err)
+	replacement.Stop()
+
+	// The inputs of the configuration file keep their ids.
+	bt.runners = append(bt.runners, input.NewRunner("ses_events", nil))
+	_, err = factory.Create(nil, common.MustNewConfigFrom(common.MapStr{"type": "ses_events"}), nil)
+	a.Error(err)
+}
+
+func TestCheckThenCreate(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "reload")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	a.NoError(ioutil.WriteFile(filepath.Join(dir, "inputs.yml"), []byte("- type: ses_events\n  id: checked\n"), 0600))
+
+	bt := &Symantecbeat{
+		config: config.DefaultConfig,
+		router: index.NewRouter(config.DefaultConfig.Index),
+	}
+	factory := &runnerFactory{bt: bt}
+	reloader := cfgfile.NewReloader(nil, common.MustNewConfigFrom(common.MapStr{
+		"path":           filepath.Join(dir, "*.yml"),
+		"reload.enabled": false,
+	}))
+
+	// Checking the entries does not keep their ids from the runners created
+	// next.
+	handoverTimeout = 10 * time.Millisecond
+	defer func() { handoverTimeout = 5 * time.Second }()
+	a.NoError(reloader.Check(factory))
+	runner, err := factory.Create(nil, common.MustNewConfigFrom(common.MapStr{"type": "ses_events", "id": "checked"}), nil)
+	if a.NoError(err) {
+		a.Equal("inputs [checked]", runner.String())
+	}
+}
@@ -18,100 +18,314 @@
 package beater
 
 import (
+	"flag"
 	"fmt"
 	"time"
 
 	"github.com/marian-craciunescu/symantecbeat/client"
 
 	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/cfgfile"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs/elasticsearch"
+	"github.com/elastic/beats/libbeat/paths"
 
 	"github.com/marian-craciunescu/symantecbeat/config"
+	"github.com/marian-craciunescu/symantecbeat/index"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/inventory"
+	"github.com/marian-craciunescu/symantecbeat/pipeline"
 )
 
+var once = flag.Bool("once", false, "Collect from the checkpoints up to now, or end_date, and exit")
+
 // Symantecbeat configuration.
 type Symantecbeat struct {
-	done     chan struct{}
-	config   config.Config
-	client   beat.Client
-	smClient client.SymantecClient
-	lastRun  time.Time
+	done    chan struct{}
+	config  config.Config
+	router  *index.Router
+	version string
+	// once runs every input once instead of every period.
+	once bool
+	// ctx is the context of the inputs using the SES credentials of the beat.
+	ctx input.Context
+
+	runners []*input.Runner
 }
 
 // New creates an instance of symantecbeat.
 func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
-	c := config.DefaultConfig
-	if err := cfg.Unpack(&c); err != nil {
+	c, err := config.Unpack(cfg)
+	if err != nil {
 		return nil, fmt.Errorf("Error reading config file: %v", err)
 	}
 	logp.Info("using config %v", c)
-	sm := client.NewSymantecClient(c.ApiURL, c.CustomerID, c.DomainID, c.ClientID, c.ClientSecret)
 
 	bt := &Symantecbeat{
-		done:     make(chan struct{}),
-		config:   c,
-		smClient: sm,
-		lastRun:  time.Now().UTC().Add(-1 * c.StartDate),
+		done:    make(chan struct{}),
+		config:  c,
+		router:  index.NewRouter(c.Index),
+		version: b.Info.Version,
+		once:    c.RunOnce || *once,
+	}
+	if c.EndDate != "" && !bt.once {
+		return nil, fmt.Errorf("Error reading config file: end_date is only used with run_once")
+	}
+	if bt.once && c.ConfigInputs.Enabled() {
+		return nil, fmt.Errorf("Error reading config file: config.inputs cannot be reloaded with run_once")
+	}
+
+	inputs, err := c.InputConfigs(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file: %v", err)
+	}
+	sm, err := c.NewClient(c.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file: %v", err)
+	}
+	devices := inventory.NewCache()
+	bt.ctx = input.Context{
+		Client:           sm,
+		Devices:          devices,
+		Build:            bt.eventBuilder(devices),
+		KeySanitization:  c.KeySanitization,
+		PreserveOriginal: c.PreserveOriginal,
+	}
+	runners, err := bt.newRunners(inputs, bt.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range c.Tenants {
+		tenantRunners, err := bt.tenantRunners(t)
+		if err != nil {
+			return nil, err
+		}
+		runners = append(runners, tenantRunners...)
 	}
+	for _, runner := range runners {
+		if bt.hasRunner(runner.ID()) {
+			return nil, fmt.Errorf("Error creating input: id %s is used by several inputs", runner.ID())
+		}
+		if bt.once && !runner.CanRunOnce() {
+			return nil, fmt.Errorf("Error creating input: input %s cannot run once", runner.ID())
+		}
+		bt.runners = append(bt.runners, runner)
+	}
+	bt.setupPipelineLoaderCallback(b)
 	return bt, nil
 }
 
+// tenantRunners creates the runners of the inputs of a tenant. They share the
+// devices, the rate limit and the static fields of the tenant, and nothing
+// with the other tenants.
+func (bt *Symantecbeat) tenantRunners(t config.TenantConfig) ([]*input.Runner, error) {
+	inputs, err := config.TenantInputConfigs(t)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file: %v", err)
+	}
+
+	sm, err := bt.config.TenantClient(t)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file: %v", err)
+	}
+	devices := inventory.NewCache()
+	ctx := input.Context{
+		Tenant:  t.ID,
+		Fields:  common.MapStr{"organization": common.MapStr{"id": t.ID}},
+		Tags:    t.Tags,
+		Client:  sm,
+		Devices: devices,
+		Build:   bt.eventBuilder(devices),
+
+		KeySanitization:  bt.config.KeySanitization,
+		PreserveOriginal: bt.config.PreserveOriginal,
+	}
+	return bt.newRunners(inputs, ctx)
+}
+
+// newRunners creates the runners of the enabled inputs.
+func (bt *Symantecbeat) newRunners(inputs []*common.Config, ctx input.Context) ([]*input.Runner, error) {
+	var runners []*input.Runner
+	for _, inputConfig := range inputs {
+		runner, err := input.New(inputConfig, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating input: %v", err)
+		}
+		if runner != nil {
+			runners = append(runners, runner)
+		}
+	}
+	return runners, nil
+}
+
+// hasRunner tells whether an input of the configuration file has the given
+// id.
+func (bt *Symantecbeat) hasRunner(id string) bool {
+	for _, r := range bt.runners {
+		if r.ID() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// setupPipelineLoaderCallback sets the callback loading the ingest pipelines
+// during `symantecbeat setup --pipelines`.
+func (bt *Symantecbeat) setupPipelineLoaderCallback(b *beat.Beat) {
+	if !bt.config.IngestPipelines || b.Config.Output.Name() != "elasticsearch" {
+		return
+	}
+
+	version := b.Info.Version
+	b.OverwritePipelinesCallback = func(esConfig *common.Config) error {
+		esClient, err := elasticsearch.NewConnectedClient(esConfig)
+		if err != nil {
+			return err
+		}
+		return pipeline.Load(esClient, version, true)
+	}
+}
+
+// loadPipelines registers the ingest pipelines to be loaded every time a new
+// Elasticsearch connection is established. The events are published without
+// pipelines when the ingest directory has none, rather than rejected by
+// Elasticsearch.
+func (bt *Symantecbeat) loadPipelines(b *beat.Beat) error {
+	if !bt.config.IngestPipelines || b.Config.Output.Name() != "elasticsearch" {
+		return nil
+	}
+	if !pipeline.Found() {
+		logp.Warn("ingest_pipelines is set but no ingest pipelines were found in %s, publishing the events without them",
+			paths.Resolve(paths.Home, pipeline.Dir))
+		bt.config.IngestPipelines = false
+		return nil
+	}
+
+	version := b.Info.Version
+	overwrite := bt.config.OverwritePipelines
+	callback := func(esClient *elasticsearch.Client) error {
+		return pipeline.Load(esClient, version, overwrite)
+	}
+	_, err := elasticsearch.RegisterConnectCallback(callback)
+	return err
+}
+
+// loadTemplates registers the per event type index templates to be loaded
+// every time a new Elasticsearch connection is established.
+func (bt *Symantecbeat) loadTemplates(b *beat.Beat) error {
+	if bt.router == nil || b.Config.Output.Name() != "elasticsearch" {
+		return nil
+	}
+
+	info, fields := b.Info, b.Fields
+	callback := func(esClient *elasticsearch.Client) error {
+		return bt.router.LoadTemplates(esClient, info, fields)
+	}
+	_, err := elasticsearch.RegisterConnectCallback(callback)
+	return err
+}
+
 // Run starts symantecbeat.
 func (bt *Symantecbeat) Run(b *beat.Beat) error {
 	logp.Info("symantecbeat is running! Hit CTRL-C to stop it.")
 
-	var err error
-	bt.client, err = b.Publisher.Connect()
-	if err != nil {
+	if err := bt.loadPipelines(b); err != nil {
+		return err
+	}
+	if err := bt.loadTemplates(b); err != nil {
 		return err
 	}
 
-	ticker := time.NewTicker(bt.config.Period)
-	for {
-		select {
-		case <-bt.done:
-			return nil
-		case <-ticker.C:
-			{
-				logp.Info("Starting ticker cycle at time=%s", time.Now().Format(time.RFC3339))
-				err := bt.smClient.GetOauthToken()
-				if err != nil {
-					logp.Err("Error getting the accesToken.Check credentials error=%s", err.Error())
-					continue
-				}
-
-				end := time.Now().UTC()
-				for eventType := range client.AllTypes {
-					t := client.EventType(eventType)
-					mapStrArr, err := bt.smClient.DoRequest(bt.lastRun, end, t, bt.config.BatchSize)
-					if err != nil {
-						logp.Err("Error while doing request.Err=%s", err.Error())
-					} else {
-						for _, mapStr := range mapStrArr {
-							ts := time.Now()
-							if err == nil {
-								event := beat.Event{
-									Timestamp: ts,
-									Fields:    mapStr,
-								}
-								bt.client.Publish(event)
-							}
-
-						}
-
-					}
-				}
-				bt.lastRun = end
-			}
+	if bt.once {
+		return bt.runOnce(b)
+	}
+
+	for _, runner := range bt.runners {
+		if err := runner.Start(b.Publisher); err != nil {
+			return err
+		}
+	}
 
+	if bt.config.ConfigInputs.Enabled() {
+		reloader := cfgfile.NewReloader(b.Publisher, bt.config.ConfigInputs)
+		factory := &runnerFactory{bt: bt}
+		if err := reloader.Check(factory); err != nil {
+			return err
 		}
+		go reloader.Run(factory)
+		defer reloader.Stop()
+	}
+
+	<-bt.done
+	return nil
+}
+
+// runOnce runs every input once, in parallel, up to the end date. It returns
+// an error when an input failed, so the exit status of the beat reflects it.
+func (bt *Symantecbeat) runOnce(b *beat.Beat) error {
+	end := bt.config.End(time.Now().UTC())
+	logp.Info("Collecting once up to %s", end.Format(time.RFC3339))
 
+	errs := make(chan error, len(bt.runners))
+	for _, runner := range bt.runners {
+		go func(runner *input.Runner) {
+			errs <- runner.RunOnce(b.Publisher, end)
+		}(runner)
 	}
+	failed := 0
+	for range bt.runners {
+		if err := <-errs; err != nil {
+			logp.Err("Error running once: %v", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed", failed, len(bt.runners))
+	}
+	return nil
+}
+
+// eventBuilder returns the builder of the events in the SES layout, enriched
+// with devices. It is shared by the inputs through their context.
+func (bt *Symantecbeat) eventBuilder(devices *inventory.Cache) client.EventBuilder {
+	return func(t client.EventType, fields common.MapStr) beat.Event {
+		devices.Enrich(fields)
+		event := beat.Event{
+			Timestamp: time.Now(),
+			Fields:    fields,
+		}
+		if bt.config.DeviceTimestamp {
+			event.Timestamp = eventTime(fields)
+		}
+		if bt.config.IngestPipelines {
+			event.Meta = common.MapStr{
+				"pipeline": pipeline.ID(bt.version, pipeline.ForEventType(t)),
+			}
+		}
+		bt.router.Apply(&event, t)
+		return event
+	}
+}
+
+// eventTime returns the time the event occurred on the device, falling back
+// to the current time.
+func eventTime(fields common.MapStr) time.Time {
+	if ts, ok := fields["device_time"].(float64); ok {
+		return time.Unix(0, int64(ts)*int64(time.Millisecond)).UTC()
+	}
+	if ts, ok := fields["time"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			return t
+		}
+	}
+	return time.Now()
 }
 
 // Stop stops symantecbeat.
 func (bt *Symantecbeat) Stop() {
-	_ = bt.client.Close()
+	for _, runner := range bt.runners {
+		runner.Stop()
+	}
 	close(bt.done)
 }
@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	_ "github.com/marian-craciunescu/symantecbeat/stream"
+)
+
+func TestRunOnceInputs(t *testing.T) {
+	a := assert.New(t)
+
+	b := &beat.Beat{Config: &beat.BeatConfig{}}
+	newBeat := func(settings common.MapStr) (*Symantecbeat, error) {
+		cfg := common.MustNewConfigFrom(common.MapStr{
+			"customer_id":   "customer",
+			"domain_id":     "domain",
+			"client_id":     "id",
+			"client_secret": "secret",
+		})
+		a.NoError(cfg.Merge(settings))
+		bt, err := New(b, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return bt.(*Symantecbeat), nil
+	}
+
+	bt, err := newBeat(common.MapStr{
+		"run_once": true,
+		"end_date": "2026-10-19T00:00:00Z",
+		"inputs":   []common.MapStr{{"type": "ses_events"}},
+	})
+	a.NoError(err)
+	a.True(bt.once)
+
+	_, err = newBeat(common.MapStr{
+		"run_once": true,
+		"inputs":   []common.MapStr{{"type": "ses_stream", "stream_id": "s1"}},
+	})
+	if a.Error(err) {
+		a.Contains(err.Error(), "input ses_stream cannot run once")
+	}
+
+	_, err = newBeat(common.MapStr{"end_date": "2026-10-19T00:00:00Z"})
+	if a.Error(err) {
+		a.Contains(err.Error(), "run_once")
+	}
+}
@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const devicesURL = "/sccs/v1/devices"
+
+// Device is a device of the SES device inventory.
+type Device struct {
+	UID             string   `json:"id"`
+	Name            string   `json:"name"`
+	Domain          string   `json:"domain"`
+	OS              DeviceOS `json:"os"`
+	AgentVersion    string   `json:"agent_version"`
+	DeviceGroupID   string   `json:"parent_device_group_id"`
+	DeviceGroupName string   `json:"parent_device_group_name"`
+	IPv4Addresses   []string `json:"ipv4_addresses"`
+	MACAddresses    []string `json:"mac_addresses"`
+	Online          bool     `json:"is_online"`
+	Owner           string   `json:"user"`
+}
+
+// DeviceOS is the operating system of a device.
+type DeviceOS struct {
+	Name    string `json:"name"`
+	Version string `json:"ver"`
+}
+
+type devicesResponse struct {
+	Total   int      `json:"total"`
+	Devices []Device `json:"devices"`
+}
+
+// GetDevices pages through the whole SES device inventory.
+func (s *SymantecClient) GetDevices(pageSize int) ([]Device, error) {
+	var devices []Device
+	for offset := 0; ; offset += pageSize {
+		path := fmt.Sprintf("%s?offset=%d&limit=%d", devicesURL, offset, pageSize)
+		body, err := s.do(http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page devicesResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("error decoding devices response: %v", err)
+		}
+		devices = append(devices, page.Devices...)
+
+		if len(page.Devices) < pageSize || len(devices) >= page.Total {
+			break
+		}
+	}
+	s.logger.Infof("Got %d devices", len(devices))
+	return devices, nil
+}
+
+// MapStr returns the device as the fields of an inventory document.
+func (d Device) MapStr() common.MapStr {
+	return common.MapStr{
+		"uid":           d.UID,
+		"name":          d.Name,
+		"domain":        d.Domain,
+		"agent_version": d.AgentVersion,
+		"group": common.MapStr{
+			"id":   d.DeviceGroupID,
+			"name": d.DeviceGroupName,
+		},
+		"os": common.MapStr{
+			"name":    d.OS.Name,
+			"version": d.OS.Version,
+		},
+		"ip":     d.IPv4Addresses,
+		"mac":    d.MACAddresses,
+		"online": d.Online,
+		"owner":  d.Owner,
+	}
+}
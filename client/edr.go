@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// EDR appliance endpoints, relative to the /atpapi API URL of the appliance.
+const (
+	EDREventsURL    = "/v2/events"
+	EDRIncidentsURL = "/v2/incidents"
+)
+
+type edrQuery struct {
+	Verb      string `json:"verb"`
+	Query     string `json:"query,omitempty"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Limit     int    `json:"limit"`
+	Next      string `json:"next,omitempty"`
+}
+
+type edrResponse struct {
+	Next   string            `json:"next"`
+	Total  int               `json:"total"`
+	Result []json.RawMessage `json:"result"`
+}
+
+// QueryEDR requests a page of the results of a query of a Symantec EDR
+// appliance endpoint, EDREventsURL or EDRIncidentsURL, between start and end.
+// next is empty for the first page and then the one returned by the previous
+// page; it is empty after the last page. The client must be created with the
+// /atpapi URL of the appliance.
+func (s *SymantecClient) QueryEDR(path string, start, end time.Time, query string, size int, next string) ([]common.MapStr, string, error) {
+	request := edrQuery{
+		Verb:      "query",
+		Query:     query,
+		StartTime: start.Format(timeFormat),
+		EndTime:   end.Format(timeFormat),
+		Limit:     size,
+		Next:      next,
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := s.do(http.MethodPost, path, body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var page edrResponse
+	if err := json.Unmarshal(response, &page); err != nil {
+		return nil, "", fmt.Errorf("error decoding %s response: %v", path, err)
+	}
+	var results []common.MapStr
+	for _, raw := range page.Result {
+		fields, err := s.newEvent(raw)
+		if err != nil {
+			s.logger.Errorf("dropping EDR result err=%s", err.Error())
+			continue
+		}
+		results = append(results, fields)
+	}
+
+	if len(page.Result) == 0 {
+		return results, "", nil
+	}
+	return results, page.Next, nil
+}
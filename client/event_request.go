@@ -19,7 +19,11 @@ package client
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
 )
 
 type EventType int
@@ -53,6 +57,25 @@ const (
 	WEB_SECURITY
 )
 
+// Event types of the documents collected from the SES APIs other than the
+// event export and from the other Symantec products. They are not exported,
+// so they are not part of AllTypes.
+const (
+	DEVICE_INVENTORY EventType = WEB_SECURITY + 1 + iota
+	INCIDENT
+	SEPM_COMPUTER
+	SEPM_CRITICAL_EVENT
+	SEPM_COMMAND
+	EMAIL_SECURITY
+	DLP_INCIDENT
+	EDR_EVENT
+	EDR_INCIDENT
+)
+
+// UNKNOWN is the type of streamed events whose feature is not one of the
+// known event types.
+const UNKNOWN EventType = -1
+
 var AllTypes = []EventType{
 	AGENT_FRAMEWORK,
 	APP_CONTROL,
@@ -82,6 +105,19 @@ var AllTypes = []EventType{
 	WEB_SECURITY,
 }
 
+// CollectedTypes are the event types of the documents that are not exported.
+var CollectedTypes = []EventType{
+	DEVICE_INVENTORY,
+	INCIDENT,
+	SEPM_COMPUTER,
+	SEPM_CRITICAL_EVENT,
+	SEPM_COMMAND,
+	EMAIL_SECURITY,
+	DLP_INCIDENT,
+	EDR_EVENT,
+	EDR_INCIDENT,
+}
+
 func (t EventType) String() string {
 	names := [...]string{
 		"AGENT FRAMEWORK ",
@@ -110,29 +146,90 @@ func (t EventType) String() string {
 		"VR ASSESSMENT",
 		"VR REMEDIATION",
 		"WEB SECURITY",
+		"DEVICE INVENTORY",
+		"INCIDENT",
+		"SEPM COMPUTER",
+		"SEPM CRITICAL EVENT",
+		"SEPM COMMAND",
+		"EMAIL SECURITY",
+		"DLP INCIDENT",
+		"EDR EVENT",
+		"EDR INCIDENT",
 	}
 
-	if t < AGENT_FRAMEWORK || t > WEB_SECURITY {
+	if t < AGENT_FRAMEWORK || t > EDR_INCIDENT {
 		return "Unknown"
 	}
 	return names[t]
 }
 
+// Name returns the lowercase, underscore separated name of the event type,
+// e.g. malware_protection, used to reference it in the configuration.
+func (t EventType) Name() string {
+	return typeName(t.String())
+}
+
+// typeName turns the SES name of an event type, e.g. MALWARE PROTECTION or
+// MALWARE_PROTECTION, into its Name.
+func typeName(s string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(s), " ", "_", -1))
+}
+
+// EventTypeByName returns the event type with the given Name.
+func EventTypeByName(name string) (EventType, bool) {
+	for _, t := range AllTypes {
+		if t.Name() == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// EventTypeOf returns the event type of a streamed SES event from its
+// feature_name, e.g. MALWARE_PROTECTION.
+func EventTypeOf(fields map[string]interface{}) EventType {
+	feature, ok := fields["feature_name"].(string)
+	if !ok {
+		return UNKNOWN
+	}
+	if t, ok := EventTypeByName(typeName(feature)); ok {
+		return t
+	}
+	return UNKNOWN
+}
+
+// EventBuilder turns the fields of an event, in the SES layout, into the event
+// published by the beat. t selects its ingest pipeline and index.
+type EventBuilder func(t EventType, fields common.MapStr) beat.Event
+
+// Rebuild passes an event made by a collector through build, keeping the time
+// the collector set, e.g. the creation time of an incident.
+func (build EventBuilder) Rebuild(t EventType, event beat.Event) beat.Event {
+	built := build(t, event.Fields)
+	built.Timestamp = event.Timestamp
+	return built
+}
+
 const timeFormat = "2006-01-02T15:04:05.999Z"
 
+// MaxBatchSize is the largest batchSize the export API accepts.
+const MaxBatchSize = 10000
+
 type eventRequest struct {
 	BatchSize  int    `json:"batchSize"`
 	EventsType string `json:"type"`
 	StartDate  string `json:"startDate"`
 	EndDate    string `json:"endDate"`
+	Query      string `json:"query,omitempty"`
 }
 
-func NewEventEncoded(s, end time.Time, size int, t EventType) ([]byte, error) {
+func NewEventEncoded(s, end time.Time, size int, t EventType, query string) ([]byte, error) {
 	event := eventRequest{
 		StartDate:  s.Format(timeFormat),
 		EndDate:    end.Format(timeFormat),
 		BatchSize:  size,
 		EventsType: t.String(),
+		Query:      query,
 	}
 
 	jsonValue, err := json.Marshal(event)
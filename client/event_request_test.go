@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestCollectedTypes(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("DLP INCIDENT", DLP_INCIDENT.String())
+	a.Equal("sepm_critical_event", SEPM_CRITICAL_EVENT.Name())
+	a.Equal("Unknown", (EDR_INCIDENT + 1).String())
+	for _, ct := range CollectedTypes {
+		_, ok := EventTypeByName(ct.Name())
+		a.False(ok, "%s is not exported", ct.Name())
+	}
+}
+
+func TestRebuild(t *testing.T) {
+	a := assert.New(t)
+
+	var built EventType
+	build := EventBuilder(func(t EventType, fields common.MapStr) beat.Event {
+		built = t
+		return beat.Event{
+			Timestamp: time.Now(),
+			Meta:      common.MapStr{"pipeline": "default"},
+			Fields:    fields,
+		}
+	})
+
+	ts := time.Date(2020, 4, 8, 11, 59, 0, 0, time.UTC)
+	event := build.Rebuild(EMAIL_SECURITY, beat.Event{Timestamp: ts, Fields: common.MapStr{"event_type": "EMAIL SECURITY"}})
+	a.Equal(EMAIL_SECURITY, built)
+	a.Equal(ts, event.Timestamp)
+	a.Equal("default", event.Meta["pipeline"])
+	a.Equal("EMAIL SECURITY", event.Fields["event_type"])
+}
@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/safemapstr"
+)
+
+// KeySanitization selects how the keys of a SES event are copied into the
+// published event.
+type KeySanitization string
+
+const (
+	// KeepKeys copies every key as it is, dotted keys included.
+	KeepKeys KeySanitization = "keep"
+	// DedotKeys replaces the dots of every key, at any depth, with underscores.
+	DedotKeys KeySanitization = "dedot"
+	// NestKeys expands dotted keys into objects. Colliding keys are renamed
+	// instead of failing the event.
+	NestKeys KeySanitization = "nest"
+)
+
+// Unpack validates the key_sanitization setting.
+func (k *KeySanitization) Unpack(s string) error {
+	switch v := KeySanitization(strings.ToLower(s)); v {
+	case KeepKeys, DedotKeys, NestKeys:
+		*k = v
+		return nil
+	}
+	return fmt.Errorf("unknown key_sanitization '%s', expected one of keep, dedot or nest", s)
+}
+
+// DecodeEvent decodes an event in the SES schema, applying the given key
+// sanitization strategy. preserveOriginal stores the untouched event in
+// event.original.
+func DecodeEvent(raw json.RawMessage, strategy KeySanitization, preserveOriginal bool) (common.MapStr, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	mapStr, err := transformToMapStr(m, strategy)
+	if err != nil {
+		return nil, err
+	}
+	if preserveOriginal {
+		mapStr.Put("event.original", string(raw))
+	}
+	return mapStr, nil
+}
+
+// transformToMapStr copies the decoded SES event into a new MapStr, applying
+// the given key sanitization strategy.
+func transformToMapStr(intialMap map[string]interface{}, strategy KeySanitization) (common.MapStr, error) {
+	mapStr := common.MapStr{}
+	for k, v := range intialMap {
+		switch strategy {
+		case NestKeys:
+			if err := safemapstr.Put(mapStr, k, v); err != nil {
+				return nil, fmt.Errorf("error putting field %s in map: %v", k, err)
+			}
+		case DedotKeys:
+			mapStr[dedot(k)] = dedotValue(v)
+		default:
+			mapStr[k] = v
+		}
+	}
+	return mapStr, nil
+}
+
+func dedot(key string) string {
+	return strings.Replace(key, ".", "_", -1)
+}
+
+func dedotValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, inner := range value {
+			m[dedot(k)] = dedotValue(inner)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(value))
+		for i, inner := range value {
+			arr[i] = dedotValue(inner)
+		}
+		return arr
+	default:
+		return v
+	}
+}
@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rawEvent = `{"type_id":8031,"device.name":"host-1","device":{"os.name":"Windows"}}`
+
+func TestTransformToMapStr(t *testing.T) {
+	a := assert.New(t)
+
+	var m map[string]interface{}
+	a.NoError(json.Unmarshal([]byte(rawEvent), &m))
+
+	kept, err := transformToMapStr(m, KeepKeys)
+	a.NoError(err)
+	a.Equal("host-1", kept["device.name"])
+	a.Equal(map[string]interface{}{"os.name": "Windows"}, kept["device"])
+
+	dedotted, err := transformToMapStr(m, DedotKeys)
+	a.NoError(err)
+	a.Equal("host-1", dedotted["device_name"])
+	a.Equal(map[string]interface{}{"os_name": "Windows"}, dedotted["device"])
+
+	nested, err := transformToMapStr(m, NestKeys)
+	a.NoError(err)
+	name, err := nested.GetValue("device.name")
+	a.NoError(err)
+	a.Equal("host-1", name)
+}
+
+func TestNewEventPreservesOriginal(t *testing.T) {
+	a := assert.New(t)
+
+	cl := NewSymantecClient("aaa", "", "", client_id, client_sercret)
+	cl.PreserveOriginal = true
+
+	event, err := cl.newEvent(json.RawMessage(rawEvent))
+	a.NoError(err)
+
+	original, err := event.GetValue("event.original")
+	a.NoError(err)
+	a.Equal(rawEvent, original)
+	a.Equal(map[string]interface{}{"os.name": "Windows"}, event["device"])
+}
+
+func TestKeySanitizationUnpack(t *testing.T) {
+	a := assert.New(t)
+
+	var k KeySanitization
+	a.NoError(k.Unpack("Dedot"))
+	a.Equal(DedotKeys, k)
+	a.Error(k.Unpack("flatten"))
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter restricts the events of a type exported by SES. It is sent as the
+// query of the export request so the filtering happens server side.
+type Filter struct {
+	// Query is a raw SES query string, e.g. "type_id:8031".
+	Query        string   `config:"query"`
+	Severities   []int    `config:"severity"`
+	DeviceGroups []string `config:"device_group"`
+	Products     []string `config:"product"`
+}
+
+// NewFilters resolves the filters configured by event type name.
+func NewFilters(config map[string]Filter) (map[EventType]Filter, error) {
+	filters := make(map[EventType]Filter, len(config))
+	for name, filter := range config {
+		t, ok := EventTypeByName(name)
+		if !ok {
+			return nil, fmt.Errorf("filter configured for unknown event type '%s'", name)
+		}
+		filters[t] = filter
+	}
+	return filters, nil
+}
+
+// String returns the SES query string of the filter. Conditions are joined
+// with AND, the values of a single condition with OR.
+func (f Filter) String() string {
+	var clauses []string
+	if f.Query != "" {
+		clauses = append(clauses, "("+f.Query+")")
+	}
+
+	severities := make([]string, len(f.Severities))
+	for i, severity := range f.Severities {
+		severities[i] = strconv.Itoa(severity)
+	}
+	clauses = appendClause(clauses, "severity_id", severities)
+	clauses = appendClause(clauses, "device_group", quoteAll(f.DeviceGroups))
+	clauses = appendClause(clauses, "product_name", quoteAll(f.Products))
+
+	return strings.Join(clauses, " AND ")
+}
+
+func appendClause(clauses []string, field string, values []string) []string {
+	if len(values) == 0 {
+		return clauses
+	}
+	return append(clauses, fmt.Sprintf("%s:(%s)", field, strings.Join(values, " OR ")))
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return quoted
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterString(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("", Filter{}.String())
+
+	f := Filter{
+		Query:        "type_id:8031",
+		Severities:   []int{4, 5},
+		DeviceGroups: []string{"Default/Servers"},
+	}
+	a.Equal(`(type_id:8031) AND severity_id:(4 OR 5) AND device_group:("Default/Servers")`, f.String())
+}
+
+func TestNewFilters(t *testing.T) {
+	a := assert.New(t)
+
+	filters, err := NewFilters(map[string]Filter{"malware_protection": {Products: []string{"SAEP"}}})
+	a.NoError(err)
+	a.Equal([]string{"SAEP"}, filters[MALWARE_PROTECTION].Products)
+
+	_, err = NewFilters(map[string]Filter{"malware": {}})
+	a.Error(err)
+}
+
+func TestNewEventEncodedQuery(t *testing.T) {
+	a := assert.New(t)
+
+	now := time.Now()
+	body, err := NewEventEncoded(now, now, 10, FIREWALL, "")
+	a.NoError(err)
+	a.NotContains(string(body), "query")
+
+	body, err = NewEventEncoded(now, now, 10, FIREWALL, "severity_id:(4)")
+	a.NoError(err)
+	var request eventRequest
+	a.NoError(json.Unmarshal(body, &request))
+	a.Equal("severity_id:(4)", request.Query)
+}
+
+func TestEventTypeName(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("malware_protection", MALWARE_PROTECTION.Name())
+	found, ok := EventTypeByName("malware_protection")
+	a.True(ok)
+	a.Equal(MALWARE_PROTECTION, found)
+	_, ok = EventTypeByName("MALWARE PROTECTION")
+	a.False(ok)
+
+	a.Equal(MALWARE_PROTECTION, EventTypeOf(map[string]interface{}{"feature_name": "MALWARE_PROTECTION"}))
+	a.Equal(UNKNOWN, EventTypeOf(map[string]interface{}{"feature_name": "SOMETHING_NEW"}))
+}
@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const incidentsURL = "/sccs/v1/incidents"
+
+// Incident is a SES incident. The tracked lifecycle fields are decoded,
+// Fields holds the whole incident as returned by the API.
+type Incident struct {
+	UID          string        `json:"incident_uid"`
+	StateID      int           `json:"state_id"`
+	PriorityID   int           `json:"priority_id"`
+	ResolutionID int           `json:"resolution_id"`
+	Modified     time.Time     `json:"modified"`
+	Fields       common.MapStr `json:"-"`
+}
+
+type incidentRequest struct {
+	BatchSize int    `json:"batchSize"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	Next      string `json:"next,omitempty"`
+}
+
+type incidentsResponse struct {
+	Total     int               `json:"total"`
+	Next      string            `json:"next"`
+	Incidents []json.RawMessage `json:"incidents"`
+}
+
+// GetIncidents pages through the incidents created or modified between start
+// and end, handing every page to handle. It stops at the first error returned
+// by handle.
+func (s *SymantecClient) GetIncidents(start, end time.Time, size int, handle func([]Incident) error) error {
+	request := incidentRequest{
+		BatchSize: size,
+		StartDate: start.Format(timeFormat),
+		EndDate:   end.Format(timeFormat),
+	}
+
+	total := 0
+	for {
+		body, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		response, err := s.do(http.MethodPost, incidentsURL, body)
+		if err != nil {
+			return err
+		}
+
+		var page incidentsResponse
+		if err := json.Unmarshal(response, &page); err != nil {
+			return fmt.Errorf("error decoding incidents response: %v", err)
+		}
+		var incidents []Incident
+		for _, raw := range page.Incidents {
+			incident, err := s.newIncident(raw)
+			if err != nil {
+				s.logger.Errorf("dropping incident err=%s", err.Error())
+				continue
+			}
+			incidents = append(incidents, incident)
+		}
+		if err := handle(incidents); err != nil {
+			return err
+		}
+		total += len(incidents)
+
+		if page.Next == "" || len(page.Incidents) == 0 {
+			break
+		}
+		request.Next = page.Next
+	}
+	s.logger.Infof("Got %d new or updated incidents", total)
+	return nil
+}
+
+func (s *SymantecClient) newIncident(raw json.RawMessage) (Incident, error) {
+	var incident Incident
+	if err := json.Unmarshal(raw, &incident); err != nil {
+		return incident, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return incident, err
+	}
+	fields, err := transformToMapStr(m, s.KeySanitization)
+	if err != nil {
+		return incident, err
+	}
+	incident.Fields = fields
+	return incident, nil
+}
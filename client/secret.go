@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/keystore"
+)
+
+const redacted = "[redacted]"
+
+// Secret is a credential, e.g. a client secret or a password. It is redacted
+// when formatted or encoded, so it cannot end up in the logs. Convert it to a
+// string to use it.
+type Secret string
+
+// String redacts the secret. An empty secret stays empty, so a missing
+// credential can still be told apart.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// Unpack reads the secret from the configuration.
+func (s *Secret) Unpack(value string) error {
+	*s = Secret(value)
+	return nil
+}
+
+// GoString redacts the secret in %#v.
+func (s Secret) GoString() string {
+	return s.String()
+}
+
+// MarshalJSON redacts the secret in JSON.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// SecretSource resolves a secret every time it is called, so the secret can
+// be rotated without a restart.
+type SecretSource func() (Secret, error)
+
+// FileSecret is the SecretSource reading the secret from a file.
+func FileSecret(path string) SecretSource {
+	return func() (Secret, error) {
+		return ReadSecretFile(path)
+	}
+}
+
+// KeystoreSecret is the SecretSource reading key from the keystore file at
+// path. The keystore is opened again every time, so the keys updated by the
+// keystore command are seen.
+func KeystoreSecret(path, key string) SecretSource {
+	return func() (Secret, error) {
+		store, err := keystore.NewFileKeystore(path)
+		if err != nil {
+			return "", fmt.Errorf("error opening keystore %s: %v", path, err)
+		}
+		value, err := store.Retrieve(key)
+		if err != nil {
+			return "", fmt.Errorf("error reading %s from keystore %s: %v", key, path, err)
+		}
+		secret, err := value.Get()
+		if err != nil {
+			return "", err
+		}
+		if len(secret) == 0 {
+			return "", fmt.Errorf("%s is empty in keystore %s", key, path)
+		}
+		return Secret(secret), nil
+	}
+}
+
+// ReadSecretFile reads a secret from a file, e.g. one written by a vault
+// agent. Surrounding whitespace is trimmed.
+func ReadSecretFile(path string) (Secret, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading secret file: %v", err)
+	}
+	secret := strings.TrimSpace(string(content))
+	if secret == "" {
+		return "", fmt.Errorf("secret file %s is empty", path)
+	}
+	return Secret(secret), nil
+}
@@ -0,0 +1,237 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/keystore"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestSecretIsRedacted(t *testing.T) {
+	a := assert.New(t)
+
+	config := struct {
+		ClientID     string
+		ClientSecret Secret
+	}{"id", "s3cr3t"}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		a.NotContains(fmt.Sprintf(format, config), "s3cr3t", format)
+	}
+	encoded, err := json.Marshal(config)
+	a.NoError(err)
+	a.NotContains(string(encoded), "s3cr3t")
+	a.Equal("", Secret("").String())
+}
+
+func TestReadSecretFile(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "secret")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "client_secret")
+
+	_, err = ReadSecretFile(path)
+	a.Error(err)
+
+	a.NoError(ioutil.WriteFile(path, []byte("\n"), 0600))
+	_, err = ReadSecretFile(path)
+	a.Error(err)
+
+	a.NoError(ioutil.WriteFile(path, []byte("s3cr3t\n"), 0600))
+	secret, err := ReadSecretFile(path)
+	a.NoError(err)
+	a.Equal(Secret("s3cr3t"), secret)
+}
+
+func TestCredentialsAreNotLogged(t *testing.T) {
+	a := assert.New(t)
+	a.NoError(logp.DevelopmentSetup(logp.ToObserverOutput()))
+
+	const token = "t0k3n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case loginURL:
+			fmt.Fprintf(w, `{"access_token":"%s","expires_in":3600}`, token)
+		case eventURL:
+			fmt.Fprint(w, `[]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s := NewSymantecClient(server.URL, "customer", "domain", client_id, "s3cr3t")
+	a.NoError(s.GetOauthToken())
+	_, err := s.DoRequest(time.Now().Add(-time.Hour), time.Now(), MALWARE_PROTECTION, 10)
+	a.NoError(err)
+	logp.NewLogger("test").Infof("client %v %+v", s, &s)
+
+	credentials := []string{"s3cr3t", token, s.encodeToBase64()}
+	logs := logp.ObserverLogs().All()
+	a.NotEmpty(logs)
+	for _, entry := range logs {
+		line := entry.Message + fmt.Sprint(entry.ContextMap())
+		for _, credential := range credentials {
+			a.False(strings.Contains(line, credential), "credential in log line: %s", line)
+		}
+	}
+}
+
+func TestKeystoreSecret(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "secret")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.keystore")
+	source := KeystoreSecret(path, "SES_CLIENT_SECRET")
+
+	store, err := keystore.NewFileKeystore(path)
+	a.NoError(err)
+	a.NoError(store.Create(true))
+	_, err = source()
+	a.Error(err)
+
+	a.NoError(store.Store("SES_CLIENT_SECRET", []byte("old")))
+	a.NoError(store.Save())
+	secret, err := source()
+	a.NoError(err)
+	a.Equal(Secret("old"), secret)
+
+	// The keystore is read again, so an updated key is seen.
+	a.NoError(store.Store("SES_CLIENT_SECRET", []byte("new")))
+	a.NoError(store.Save())
+	secret, err = source()
+	a.NoError(err)
+	a.Equal(Secret("new"), secret)
+}
+
+func TestSecretRotation(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "secret")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "client_secret")
+	a.NoError(ioutil.WriteFile(path, []byte("old"), 0600))
+
+	accepted := map[string]bool{"old": true}
+	tokens := 0
+	var used []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case loginURL:
+			_, secret, _ := r.BasicAuth()
+			if !accepted[secret] {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			tokens++
+			fmt.Fprintf(w, `{"access_token":"%s-%d","expires_in":3600}`, secret, tokens)
+		case eventURL:
+			used = append(used, r.Header.Get("Authorization"))
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer server.Close()
+
+	secret, err := ReadSecretFile(path)
+	a.NoError(err)
+	s := NewSymantecClient(server.URL, "", "", "id", secret)
+	s.SecretSource = FileSecret(path)
+	a.NoError(s.GetOauthToken())
+	a.Equal(Secret("old-1"), s.oauthToken)
+
+	// The new secret is not active yet: the old one keeps being used.
+	a.NoError(ioutil.WriteFile(path, []byte("new"), 0600))
+	a.NoError(s.GetOauthToken())
+	a.Equal(Secret("old"), s.ClientSecret)
+	a.Equal(Secret("old-2"), s.oauthToken)
+
+	// The old secret is revoked: the token in use stays until the new
+	// secret is accepted.
+	delete(accepted, "old")
+	a.NoError(s.GetOauthToken())
+	a.Equal(Secret("old-2"), s.oauthToken)
+
+	accepted["new"] = true
+	a.NoError(s.GetOauthToken())
+	a.Equal(Secret("new"), s.ClientSecret)
+	a.Equal(Secret("new-3"), s.oauthToken)
+
+	_, err = s.getData([]byte(`{}`))
+	a.NoError(err)
+	a.Equal([]string{"Bearer new-3"}, used)
+
+	// The secret is polled between the token requests too.
+	a.NoError(ioutil.WriteFile(path, []byte("newer"), 0600))
+	accepted["newer"] = true
+	_, err = s.getData([]byte(`{}`))
+	a.NoError(err)
+	a.Equal("Bearer new-3", used[1])
+	s.secretChecked = time.Now().Add(-secretPollPeriod)
+	_, err = s.getData([]byte(`{}`))
+	a.NoError(err)
+	a.Equal("Bearer newer-4", used[2])
+
+	// Without a valid token, failures are reported.
+	s = NewSymantecClient(server.URL, "", "", "id", "old")
+	a.Error(s.GetOauthToken())
+}
+
+func TestRejectedTokenIsRenewed(t *testing.T) {
+	a := assert.New(t)
+
+	tokens := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case loginURL:
+			tokens++
+			fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":3600}`, tokens)
+		case eventURL:
+			if r.Header.Get("Authorization") != "Bearer token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer server.Close()
+
+	s := NewSymantecClient(server.URL, "", "", "id", "secret")
+	a.NoError(s.GetOauthToken())
+	_, err := s.getData([]byte(`{}`))
+	a.NoError(err)
+	a.Equal(2, tokens)
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const streamURL = "/sccs/v1/streams"
+
+// StreamEvent is an event received from an event stream channel.
+type StreamEvent struct {
+	Type   EventType
+	Fields common.MapStr
+}
+
+// StreamBatch is the result of a single long-poll of an event stream channel.
+type StreamBatch struct {
+	// Next is the offset to read the following batch from.
+	Next   string
+	Events []StreamEvent
+}
+
+type streamResponse struct {
+	Next   string            `json:"next"`
+	Events []json.RawMessage `json:"events"`
+}
+
+// ReadStream long-polls the channel of an event stream for the events after
+// offset. The server holds the request for up to wait when no event is
+// available.
+func (s *SymantecClient) ReadStream(streamID, channelID, offset string, wait time.Duration, size int) (StreamBatch, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(size))
+	params.Set("wait", fmt.Sprint(int(wait.Seconds())))
+	if offset != "" {
+		params.Set("offset", offset)
+	}
+	path := fmt.Sprintf("%s/%s/channels/%s?%s", streamURL, url.PathEscape(streamID), url.PathEscape(channelID), params.Encode())
+
+	body, err := s.do(http.MethodGet, path, nil)
+	if err != nil {
+		return StreamBatch{}, err
+	}
+
+	var response streamResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return StreamBatch{}, fmt.Errorf("error decoding stream response: %v", err)
+	}
+
+	batch := StreamBatch{Next: response.Next}
+	if batch.Next == "" {
+		batch.Next = offset
+	}
+	for _, raw := range response.Events {
+		fields, err := s.newEvent(raw)
+		if err != nil {
+			s.logger.Errorf("dropping streamed event err=%s", err.Error())
+			continue
+		}
+		t := EventTypeOf(fields)
+		fields.Put("event_type", t.String())
+		batch.Events = append(batch.Events, StreamEvent{Type: t, Fields: fields})
+	}
+	return batch, nil
+}
@@ -21,11 +21,13 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/elastic/beats/libbeat/common"
@@ -37,37 +39,187 @@ const (
 	eventURL = "/sccs/v1/events/export"
 )
 
+var errUnauthorized = errors.New("SES request unauthorized")
+
+// StatusError is returned for the SES responses other than 200 OK.
+type StatusError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Status     string
+	// Message is the error message of the response body, when it has one.
+	Message string
+}
+
+func newStatusError(method, path string, resp *http.Response, body []byte) *StatusError {
+	var message struct {
+		Message     string `json:"message"`
+		Error       string `json:"error"`
+		Description string `json:"error_description"`
+	}
+	json.Unmarshal(body, &message)
+	e := &StatusError{Method: method, Path: path, StatusCode: resp.StatusCode, Status: resp.Status}
+	for _, m := range []string{message.Message, message.Description, message.Error} {
+		if m != "" {
+			e.Message = m
+			break
+		}
+	}
+	return e
+}
+
+func (e *StatusError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s %s returned %s: %s", e.Method, e.Path, e.Status, e.Message)
+	}
+	return fmt.Sprintf("%s %s returned %s", e.Method, e.Path, e.Status)
+}
+
+// NotEntitled tells whether err is SES refusing a request because the tenant
+// is not licensed for it, which its error message tells.
+func NotEntitled(err error) bool {
+	e, ok := err.(*StatusError)
+	if !ok {
+		return false
+	}
+	switch e.StatusCode {
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound:
+	default:
+		return false
+	}
+	message := strings.ToLower(e.Message)
+	return strings.Contains(message, "entitle") || strings.Contains(message, "licens")
+}
+
+// StatusCode returns the HTTP status code of a SES response error, or 0 when
+// err is not one, e.g. a network error.
+func StatusCode(err error) int {
+	if err == errUnauthorized {
+		return http.StatusUnauthorized
+	}
+	if e, ok := err.(*StatusError); ok {
+		return e.StatusCode
+	}
+	return 0
+}
+
 type SymantecClient struct {
 	ApiURL       string
 	CustomerID   string
 	DomainID     string
 	ClientID     string
-	ClientSecret string
-	oauthToken   string
-	logger       *logp.Logger
+	ClientSecret Secret
+	// KeySanitization is applied to the keys of every exported event.
+	KeySanitization KeySanitization
+	// PreserveOriginal stores the untouched SES payload in event.original.
+	PreserveOriginal bool
+	// Filters are sent with the export request of their event type.
+	Filters map[EventType]Filter
+	// Limiter is shared by the copies of the client. It spaces all their
+	// requests, token requests included.
+	Limiter *Limiter
+	// SecretSource resolves the client secret when set, e.g. from a file or
+	// the keystore. It is resolved again on every token request, after a
+	// rejected token and every secretPollPeriod, so the secret can be rotated
+	// without a restart.
+	SecretSource SecretSource
+	// HTTPClient sends the requests when set, e.g. with the TLS settings of
+	// an on-premises appliance.
+	HTTPClient *http.Client
+
+	oauthToken    Secret
+	tokenExpires  time.Time
+	secretChecked time.Time
+	logger        *logp.Logger
 }
 
-func NewSymantecClient(apiURL, customerID, domainID, clientID, clientSecret string) SymantecClient {
-
-	fmt.Printf("Using \ncustomerID=%s\ndomainID=%s\nclientID=%s\nclientSecret=%s\n", customerID, domainID, clientID, clientSecret)
+// secretPollPeriod is how often SecretSource is resolved again between the
+// token requests.
+const secretPollPeriod = time.Minute
 
+func NewSymantecClient(apiURL, customerID, domainID, clientID string, clientSecret Secret) SymantecClient {
 	return SymantecClient{
-		ApiURL:       apiURL,
-		CustomerID:   customerID,
-		DomainID:     domainID,
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		logger:       logp.NewLogger("symantec_client"),
+		ApiURL:          apiURL,
+		CustomerID:      customerID,
+		DomainID:        domainID,
+		ClientID:        clientID,
+		ClientSecret:    clientSecret,
+		KeySanitization: KeepKeys,
+		logger:          logp.NewLogger("symantec_client"),
 	}
 
 }
 
+// String describes the client without its credentials, which keeps its
+// token out of the logs.
+func (s SymantecClient) String() string {
+	return fmt.Sprintf("SES client url=%s customer_id=%s domain_id=%s client_id=%s", s.ApiURL, s.CustomerID, s.DomainID, s.ClientID)
+}
+
+// GoString describes the client without its credentials in %#v.
+func (s SymantecClient) GoString() string {
+	return s.String()
+}
+
+// GetOauthToken acquires a new token. When SecretSource is set, the client
+// secret is resolved first and a rotated secret is adopted once SES accepts
+// it. If no new token can be acquired, the current one is kept while it is
+// valid, so a rotation does not interrupt the collection.
 func (s *SymantecClient) GetOauthToken() error {
-	client := &http.Client{}
+	if s.rotateSecret() {
+		return nil
+	}
 
-	b64Signature := s.encodeToBase64()
+	err := s.requestToken(s.ClientSecret)
+	if err != nil && s.hasToken() {
+		s.logger.Warnf("Error renewing the token, using the current one until it expires err=%s", err.Error())
+		return nil
+	}
+	return err
+}
 
-	fmt.Println(b64Signature)
+// rotateSecret resolves the client secret from SecretSource and, when it
+// changed, acquires a token with it. It tells whether the rotated secret was
+// accepted and is now in use.
+func (s *SymantecClient) rotateSecret() bool {
+	if s.SecretSource == nil {
+		return false
+	}
+	s.secretChecked = time.Now()
+	secret, err := s.SecretSource()
+	if err != nil {
+		s.logger.Warnf("Keeping the current client secret err=%s", err.Error())
+		return false
+	}
+	if secret == s.ClientSecret {
+		return false
+	}
+	if err := s.requestToken(secret); err != nil {
+		s.logger.Warnf("The rotated client secret was not accepted, keeping the current one err=%s", err.Error())
+		return false
+	}
+	s.ClientSecret = secret
+	s.logger.Info("Acquired a token with the rotated client secret")
+	return true
+}
+
+func (s *SymantecClient) httpClient() *http.Client {
+	if s.HTTPClient != nil {
+		return s.HTTPClient
+	}
+	return &http.Client{}
+}
+
+// hasToken tells whether the current token has not expired yet.
+func (s *SymantecClient) hasToken() bool {
+	return s.oauthToken != "" && time.Now().Before(s.tokenExpires)
+}
+
+// requestToken acquires a token with the given client secret.
+func (s *SymantecClient) requestToken(secret Secret) error {
+	client := s.httpClient()
+
+	b64Signature := basicAuth(s.ClientID, secret)
 
 	uri := s.ApiURL + loginURL
 
@@ -87,6 +239,7 @@ func (s *SymantecClient) GetOauthToken() error {
 	req.Header.Add("x-epmp-domain-id", s.DomainID)
 	req.Header.Add("x-epmp-customer-id", s.CustomerID)
 
+	s.Limiter.Wait()
 	resp, err := client.Do(req)
 	if err != nil {
 		s.logger.Error(err)
@@ -104,6 +257,9 @@ func (s *SymantecClient) GetOauthToken() error {
 		s.logger.Error(err)
 		return err
 	}
+	if resp.StatusCode != http.StatusOK {
+		return newStatusError(http.MethodPost, loginURL, resp, body)
+	}
 
 	var oauthResponse oauthResponse
 	err = json.Unmarshal(body, &oauthResponse)
@@ -111,15 +267,22 @@ func (s *SymantecClient) GetOauthToken() error {
 		s.logger.Error(err)
 		return err
 	}
-	s.oauthToken = oauthResponse.Token
-	s.logger.Infof("Acquired token with %s  valid for %d", s.oauthToken, oauthResponse.Expires)
+	if oauthResponse.Token == "" {
+		return fmt.Errorf("POST %s returned no token", loginURL)
+	}
+	s.oauthToken = Secret(oauthResponse.Token)
+	s.tokenExpires = time.Now().Add(time.Duration(oauthResponse.Expires) * time.Second)
+	s.logger.Infof("Acquired token valid for %d", oauthResponse.Expires)
 	return nil
 }
 
 func (s *SymantecClient) encodeToBase64() string {
-	authorizationRawValue := fmt.Sprintf("%s:%s", s.ClientID, s.ClientSecret)
-	authValue := base64.StdEncoding.EncodeToString([]byte(authorizationRawValue))
-	return authValue
+	return basicAuth(s.ClientID, s.ClientSecret)
+}
+
+func basicAuth(clientID string, secret Secret) string {
+	authorizationRawValue := clientID + ":" + string(secret)
+	return base64.StdEncoding.EncodeToString([]byte(authorizationRawValue))
 }
 
 type oauthResponse struct {
@@ -130,39 +293,72 @@ type oauthResponse struct {
 }
 
 func (s *SymantecClient) getData(jsonValue []byte) ([]byte, error) {
+	return s.do(http.MethodPost, eventURL, jsonValue)
+}
 
-	client := &http.Client{}
+// do sends an authenticated request to the SES API and returns the response
+// body. Responses other than 200 OK are returned as errors. A rejected token
+// is renewed once, e.g. after the client secret was rotated.
+func (s *SymantecClient) do(method, path string, jsonValue []byte) ([]byte, error) {
+	if s.SecretSource != nil && time.Since(s.secretChecked) >= secretPollPeriod {
+		s.rotateSecret()
+	}
+	body, err := s.send(method, path, jsonValue)
+	if err != errUnauthorized {
+		return body, err
+	}
 
-	uri := s.ApiURL + eventURL
+	s.logger.Info("SES token rejected, acquiring a new one")
+	s.tokenExpires = time.Time{}
+	if err := s.GetOauthToken(); err != nil {
+		return nil, err
+	}
+	return s.send(method, path, jsonValue)
+}
+
+func (s *SymantecClient) send(method, path string, jsonValue []byte) ([]byte, error) {
+
+	client := s.httpClient()
 
-	req, err := http.NewRequest(http.MethodPost, uri, bytes.NewBuffer(jsonValue))
+	uri := s.ApiURL + path
+
+	var reqBody io.Reader
+	if jsonValue != nil {
+		reqBody = bytes.NewBuffer(jsonValue)
+	}
+	req, err := http.NewRequest(method, uri, reqBody)
 	if err != nil {
 		s.logger.Error(err)
 		return nil, err
 	}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", s.oauthToken))
+	req.Header.Add("Authorization", "Bearer "+string(s.oauthToken))
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("x-epmp-domain-id", s.DomainID)
 	req.Header.Add("x-epmp-product", "SAEP")
 	req.Header.Add("x-epmp-customer-id", s.CustomerID)
 
+	s.Limiter.Wait()
 	resp, err := client.Do(req)
 	if err != nil {
 		s.logger.Error(err)
 		return nil, err
 
 	}
+	defer resp.Body.Close()
 
-	s.logger.Debugf("Server response=%i", resp.StatusCode)
+	s.logger.Debugf("Server response=%d", resp.StatusCode)
 	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil || resp.StatusCode != http.StatusOK {
+	if err != nil {
 		s.logger.Error(err)
 		return nil, err
 	}
-	err = resp.Body.Close()
-	if err != nil {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, errUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = newStatusError(method, path, resp, body)
 		s.logger.Error(err)
 		return nil, err
 	}
@@ -170,13 +366,29 @@ func (s *SymantecClient) getData(jsonValue []byte) ([]byte, error) {
 	return body, nil
 }
 
+// DoRequest exports the events of type t between start and end, in pages of
+// size events.
 func (s *SymantecClient) DoRequest(start, end time.Time, t EventType, size int) (mapStrArr []common.MapStr, err error) {
+	err = s.Export(start, end, t, size, func(mapStr common.MapStr) error {
+		mapStrArr = append(mapStrArr, mapStr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapStrArr, nil
+}
+
+// Export exports the events of type t between start and end, in pages of
+// size events, and hands them to handle as they are decoded. It stops at the
+// first error returned by handle.
+func (s *SymantecClient) Export(start, end time.Time, t EventType, size int, handle func(common.MapStr) error) error {
 
 	logp.Info("DoRequest for event=%s", t.String())
 
-	requestBody, err := NewEventEncoded(start, end, size, t)
+	requestBody, err := NewEventEncoded(start, end, size, t, s.Filters[t].String())
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
 
 	batches := 0
@@ -185,51 +397,56 @@ func (s *SymantecClient) DoRequest(start, end time.Time, t EventType, size int)
 		response, err := s.getData(requestBody)
 		if err != nil {
 			logp.Err("error doing  request %s", err.Error())
-			return nil, err
+			return err
 		}
 
 		if len(response) == 0 || string(response) == "[]" {
-			fmt.Println("Finished request no_of_batches", batches)
+			s.logger.Debugf("Finished request no_of_batches=%d", batches)
 			break
 		} else {
 			reader := bytes.NewReader(response)
 			dec := json.NewDecoder(reader)
 
-			var m []map[string]interface{}
+			var m []json.RawMessage
 			if err := dec.Decode(&m); err == io.EOF {
 				break
 			} else if err != nil {
 				logp.Err("error decoding json response err=%s", err.Error())
-				return nil, err
+				return err
 			}
 
 			for i := range m {
-				newMap := m[i]
-				mapStr, err := transformToMapStr(newMap)
+				mapStr, err := s.newEvent(m[i])
 				if err != nil {
-					return nil, err
-				} else {
-					mapStr.Put("event_type", t.String())
-					mapStrArr = append(mapStrArr, mapStr)
-					noOfEvents++
+					s.logger.Errorf("dropping %s event err=%s", t.String(), err.Error())
+					continue
 				}
+				mapStr.Put("event_type", t.String())
+				if err := handle(mapStr); err != nil {
+					return err
+				}
+				noOfEvents++
 			}
 		}
 
 		batches++
 	}
 	s.logger.Infof("For type=%s  got %d in %d  batches", t.String(), noOfEvents, batches)
-	return mapStrArr, nil
+	return nil
 }
 
-func transformToMapStr(intialMap map[string]interface{}) (common.MapStr, error) {
-	mapStr := common.MapStr{}
-	for k := range intialMap {
-		_, err := mapStr.Put(k, intialMap[k])
-		if err != nil {
-			logp.Err("error puting field in map err=%s", err.Error())
-			return nil, err
-		}
+// Probe exports a single page of one event of type t between start and end.
+// It checks that the client is allowed to export t.
+func (s *SymantecClient) Probe(start, end time.Time, t EventType) error {
+	requestBody, err := NewEventEncoded(start, end, 1, t, s.Filters[t].String())
+	if err != nil {
+		return err
 	}
-	return mapStr, nil
+	_, err = s.getData(requestBody)
+	return err
+}
+
+// newEvent decodes a single SES event and copies it into a MapStr.
+func (s *SymantecClient) newEvent(raw json.RawMessage) (common.MapStr, error) {
+	return DecodeEvent(raw, s.KeySanitization, s.PreserveOriginal)
 }
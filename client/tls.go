@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+// LoadTLSConfig loads the ssl settings of a collector for the server at
+// rawURL. It returns nil when ssl is not set, to use the system defaults.
+func LoadTLSConfig(ssl *tlscommon.Config, rawURL string) (*tls.Config, error) {
+	tlsCommon, err := tlscommon.LoadTLSConfig(ssl)
+	if err != nil || tlsCommon == nil {
+		return nil, err
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return tlsCommon.BuildModuleConfig(u.Hostname()), nil
+}
+
+// NewHTTPClient creates the HTTP client of an on-premises server. tlsConfig
+// may be nil to use the system defaults.
+func NewHTTPClient(tlsConfig *tls.Config) *http.Client {
+	return &http.Client{
+		Timeout:   time.Minute,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+}
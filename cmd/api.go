@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/config"
+)
+
+// apiWindow is the time range of the export requests of `test api`, short
+// enough to return at most a handful of events.
+const apiWindow = time.Minute
+
+// apiCheck is a SES client to test with the tenant it belongs to, "-" for
+// the credentials of the beat.
+type apiCheck struct {
+	tenant string
+	client client.SymantecClient
+}
+
+// apiResult is the outcome of one request of `test api`.
+type apiResult struct {
+	tenant  string
+	request string
+	status  string
+	latency time.Duration
+	ok      bool
+}
+
+// testAPI runs `symantecbeat test api`. It prints the results of the
+// requests, and returns an error when one of them failed.
+func testAPI(cfg *common.Config) error {
+	c, err := config.Unpack(cfg)
+	if err != nil {
+		return fmt.Errorf("Error reading config file: %v", err)
+	}
+	checks, err := apiChecks(c)
+	if err != nil {
+		return fmt.Errorf("Error reading config file: %v", err)
+	}
+	if len(checks) == 0 {
+		return errors.New("no input collects from SES")
+	}
+
+	if !printAPIResults(os.Stdout, runAPIChecks(checks, time.Now().UTC())) {
+		return errors.New("SES API test failed")
+	}
+	return nil
+}
+
+// apiChecks returns the clients of the configured SES credentials.
+func apiChecks(c config.Config) ([]apiCheck, error) {
+	var checks []apiCheck
+	usesCredentials, err := c.UsesCredentials()
+	if err != nil {
+		return nil, err
+	}
+	if usesCredentials {
+		sm, err := c.NewClient(c.Credentials)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, apiCheck{tenant: "-", client: sm})
+	}
+	for _, t := range c.Tenants {
+		sm, err := c.TenantClient(t)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, apiCheck{tenant: t.ID, client: sm})
+	}
+	return checks, nil
+}
+
+// runAPIChecks acquires a token with every client and exports one page of each
+// event type over the window ending at end. The event types are skipped when
+// no token could be acquired.
+func runAPIChecks(checks []apiCheck, end time.Time) []apiResult {
+	var results []apiResult
+	for _, check := range checks {
+		sm := check.client
+
+		start := time.Now()
+		err := sm.GetOauthToken()
+		results = append(results, newAPIResult(check.tenant, "oauth", err, time.Since(start), false))
+		if err != nil {
+			continue
+		}
+
+		for _, t := range client.AllTypes {
+			start := time.Now()
+			err := sm.Probe(end.Add(-apiWindow), end, t)
+			results = append(results, newAPIResult(check.tenant, t.Name(), err, time.Since(start), true))
+		}
+	}
+	return results
+}
+
+// newAPIResult describes the outcome of a request. The export of an event
+// type the tenant is not licensed for is not a failure, the inputs skip
+// nothing they could collect.
+func newAPIResult(tenant, request string, err error, latency time.Duration, export bool) apiResult {
+	result := apiResult{tenant: tenant, request: request, latency: latency}
+	switch code := client.StatusCode(err); {
+	case err == nil:
+		result.status = "OK"
+		result.ok = true
+	case export && client.NotEntitled(err):
+		result.status = "not entitled"
+		result.ok = true
+	case code == http.StatusUnauthorized:
+		result.status = "401 unauthorized"
+	case code == http.StatusForbidden:
+		result.status = "403 forbidden"
+	default:
+		result.status = err.Error()
+	}
+	return result
+}
+
+// printAPIResults writes the results as a table and tells whether all the
+// requests succeeded.
+func printAPIResults(out io.Writer, results []apiResult) bool {
+	ok := true
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TENANT\tREQUEST\tSTATUS\tLATENCY")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", r.tenant, r.request, r.status, r.latency.Round(time.Millisecond))
+		ok = ok && r.ok
+	}
+	w.Flush()
+	return ok
+}
@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/config"
+)
+
+// sesServer answers the token requests with tokenStatus and the exports with
+// the status set for their event type, 200 OK by default.
+func sesServer(tokenStatus int, exports map[string]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokens":
+			w.WriteHeader(tokenStatus)
+			fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+		case "/sccs/v1/events/export":
+			var request struct {
+				Type string `json:"type"`
+			}
+			json.NewDecoder(r.Body).Decode(&request)
+			if status, ok := exports[request.Type]; ok {
+				w.WriteHeader(status)
+				if status == http.StatusForbidden && request.Type == client.TDAD_PROTECT.String() {
+					fmt.Fprint(w, `{"error":"forbidden","message":"Customer is not entitled to this feature"}`)
+				}
+				return
+			}
+			fmt.Fprint(w, `[]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newAPIConfig(t *testing.T, settings common.MapStr) config.Config {
+	c, err := config.Unpack(common.MustNewConfigFrom(settings))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRunAPIChecks(t *testing.T) {
+	a := assert.New(t)
+
+	server := sesServer(http.StatusOK, map[string]int{
+		client.FIREWALL.String():           http.StatusForbidden,
+		client.TDAD_PROTECT.String():       http.StatusForbidden,
+		client.MALWARE_PROTECTION.String(): http.StatusBadRequest,
+	})
+	defer server.Close()
+
+	checks, err := apiChecks(newAPIConfig(t, common.MapStr{
+		"url":           server.URL,
+		"customer_id":   "customer",
+		"domain_id":     "domain",
+		"client_id":     "id",
+		"client_secret": "secret",
+	}))
+	a.NoError(err)
+	results := runAPIChecks(checks, time.Now().UTC())
+
+	a.Len(results, 1+len(client.AllTypes))
+	status := map[string]string{}
+	for _, r := range results {
+		a.Equal("-", r.tenant)
+		status[r.request] = r.status
+	}
+	a.Equal("OK", status["oauth"])
+	a.Equal("403 forbidden", status["firewall"])
+	a.Equal("not entitled", status["tdad_protect"])
+	a.Contains(status["malware_protection"], "400 Bad Request")
+
+	var out bytes.Buffer
+	a.False(printAPIResults(&out, results))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	a.Len(lines, 1+len(results))
+	a.Contains(lines[0], "LATENCY")
+
+	// The event types the tenant is not licensed for are not failures.
+	notEntitled := &client.StatusError{StatusCode: http.StatusForbidden, Message: "Customer is not entitled to this feature"}
+	a.True(printAPIResults(&out, []apiResult{newAPIResult("-", "tdad_protect", notEntitled, 0, true)}))
+}
+
+func TestRunAPIChecksTenants(t *testing.T) {
+	a := assert.New(t)
+
+	ok := sesServer(http.StatusOK, nil)
+	defer ok.Close()
+	unauthorized := sesServer(http.StatusUnauthorized, nil)
+	defer unauthorized.Close()
+
+	tenant := func(id, url string) common.MapStr {
+		return common.MapStr{
+			"id":            id,
+			"url":           url,
+			"customer_id":   "customer",
+			"domain_id":     "domain",
+			"client_id":     "id",
+			"client_secret": "secret",
+		}
+	}
+	checks, err := apiChecks(newAPIConfig(t, common.MapStr{
+		"tenants": []common.MapStr{tenant("a", ok.URL), tenant("b", unauthorized.URL)},
+	}))
+	a.NoError(err)
+	results := runAPIChecks(checks, time.Now().UTC())
+
+	// The event types of tenant b are not tested without a token.
+	a.Len(results, 1+len(client.AllTypes)+1)
+	last := results[len(results)-1]
+	a.Equal("b", last.tenant)
+	a.Equal("oauth", last.request)
+	a.Equal("401 unauthorized", last.status)
+	a.False(printAPIResults(&bytes.Buffer{}, results))
+	a.True(printAPIResults(&bytes.Buffer{}, results[:len(results)-1]))
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/backfill"
+	"github.com/marian-craciunescu/symantecbeat/beater"
+)
+
+// backfillFlags are the flags of `symantecbeat backfill`.
+var backfillFlags struct {
+	types     *string
+	from      *string
+	to        *string
+	tenant    *string
+	state     *string
+	chunk     *time.Duration
+	workers   *int
+	rateLimit *float64
+}
+
+// runBackfill is the beat.Creator of `symantecbeat backfill`.
+func runBackfill(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
+	settings, err := newBackfillSettings(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return beater.NewBackfill(b, cfg, settings)
+}
+
+// newBackfillSettings reads the backfill from the flags. The range ends at
+// the start of the current chunk by default, so a resumed backfill has the
+// same chunks.
+func newBackfillSettings(now time.Time) (beater.BackfillSettings, error) {
+	types, err := parseEventTypes(*backfillFlags.types)
+	if err != nil {
+		return beater.BackfillSettings{}, err
+	}
+	from, err := parseExportTime("from", *backfillFlags.from, now)
+	if err != nil {
+		return beater.BackfillSettings{}, err
+	}
+	to := now.Truncate(*backfillFlags.chunk)
+	if *backfillFlags.to != "" {
+		if to, err = parseExportTime("to", *backfillFlags.to, now); err != nil {
+			return beater.BackfillSettings{}, err
+		}
+	}
+
+	return beater.BackfillSettings{
+		Backfill: backfill.Config{
+			Types:   types,
+			From:    from,
+			To:      to,
+			Chunk:   *backfillFlags.chunk,
+			Workers: *backfillFlags.workers,
+		},
+		Tenant:    *backfillFlags.tenant,
+		RateLimit: *backfillFlags.rateLimit,
+		StateFile: *backfillFlags.state,
+	}, nil
+}
@@ -0,0 +1,274 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/config"
+)
+
+// followPeriod is how often `export events --follow` exports the new events.
+const followPeriod = 30 * time.Second
+
+// errExportStopped stops an export interrupted by a signal.
+var errExportStopped = errors.New("export stopped")
+
+// exportFlags are the flags of `symantecbeat export events`.
+var exportFlags struct {
+	types  *string
+	from   *string
+	to     *string
+	query  *string
+	tenant *string
+	output *string
+	gzip   *bool
+	follow *bool
+}
+
+// exportRequest is an export of SES events outside of the inputs. It
+// exports the events between from and to, then the new ones every period
+// when following.
+type exportRequest struct {
+	types     []client.EventType
+	from      time.Time
+	to        time.Time
+	query     string
+	batchSize int
+	follow    bool
+	period    time.Duration
+}
+
+// exportEvents runs `symantecbeat export events`. It writes the events to
+// the output as NDJSON. The registries of the inputs are not used, so their
+// checkpoints are left untouched.
+func exportEvents(cfg *common.Config) error {
+	c, err := config.Unpack(cfg)
+	if err != nil {
+		return fmt.Errorf("Error reading config file: %v", err)
+	}
+	request, err := newExportRequest(c, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	sm, err := c.ClientOf(*exportFlags.tenant)
+	if err != nil {
+		return fmt.Errorf("Error reading config file: %v", err)
+	}
+	if request.query != "" {
+		sm.Filters = map[client.EventType]client.Filter{}
+		for _, t := range request.types {
+			sm.Filters[t] = client.Filter{Query: request.query}
+		}
+	}
+
+	out, err := openExportOutput(*exportFlags.output, *exportFlags.gzip)
+	if err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		close(done)
+	}()
+	defer signal.Stop(signals)
+
+	err = request.run(sm, out, done)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// newExportRequest reads the export from the flags.
+func newExportRequest(c config.Config, now time.Time) (exportRequest, error) {
+	types, err := parseEventTypes(*exportFlags.types)
+	if err != nil {
+		return exportRequest{}, err
+	}
+	from, err := parseExportTime("from", *exportFlags.from, now)
+	if err != nil {
+		return exportRequest{}, err
+	}
+	to := now
+	if *exportFlags.to != "" {
+		if *exportFlags.follow {
+			return exportRequest{}, errors.New("--to cannot be used with --follow")
+		}
+		if to, err = parseExportTime("to", *exportFlags.to, now); err != nil {
+			return exportRequest{}, err
+		}
+	}
+	if !from.Before(to) {
+		return exportRequest{}, fmt.Errorf("--from %s must be before --to %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	return exportRequest{
+		types:     types,
+		from:      from,
+		to:        to,
+		query:     *exportFlags.query,
+		batchSize: c.BatchSize,
+		follow:    *exportFlags.follow,
+		period:    followPeriod,
+	}, nil
+}
+
+// parseEventTypes parses a comma separated list of event type names, e.g.
+// firewall,malware_protection. It returns all the types when names is empty.
+func parseEventTypes(names string) ([]client.EventType, error) {
+	if names == "" {
+		return client.AllTypes, nil
+	}
+	var types []client.EventType
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		t, ok := client.EventTypeByName(name)
+		if !ok {
+			return nil, fmt.Errorf("--type: unknown event type '%s'", name)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// parseExportTime parses a RFC3339 time, or a duration before now, e.g. 2h.
+func parseExportTime(flag, value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("--%s must be a RFC3339 time, e.g. 2006-01-02T15:04:05Z, or a duration before now, e.g. 2h, got '%s'", flag, value)
+}
+
+// run writes the events as NDJSON to out until done is closed, or until they
+// are all exported when not following.
+func (r exportRequest) run(sm client.SymantecClient, out *exportOutput, done <-chan struct{}) error {
+	if err := sm.GetOauthToken(); err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(out)
+	write := func(event common.MapStr) error {
+		select {
+		case <-done:
+			return errExportStopped
+		default:
+		}
+		return enc.Encode(event)
+	}
+
+	start, end := r.from, r.to
+	for {
+		for _, t := range r.types {
+			err := sm.Export(start, end, t, r.batchSize, write)
+			if err == errExportStopped {
+				return out.Flush()
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := out.Flush(); err != nil {
+			return err
+		}
+		if !r.follow {
+			return nil
+		}
+
+		select {
+		case <-done:
+			return nil
+		case <-time.After(r.period):
+		}
+		start, end = end, time.Now().UTC()
+	}
+}
+
+// exportOutput is the file or the standard output the events are written
+// to, gzipped or not.
+type exportOutput struct {
+	*bufio.Writer
+	gz   *gzip.Writer
+	file *os.File
+}
+
+// openExportOutput creates the file at path, or writes to the standard output
+// when path is empty or -.
+func openExportOutput(path string, gzipped bool) (*exportOutput, error) {
+	out := &exportOutput{file: os.Stdout}
+	if path != "" && path != "-" {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, err
+		}
+		out.file = file
+	}
+
+	var w io.Writer = out.file
+	if gzipped {
+		out.gz = gzip.NewWriter(out.file)
+		w = out.gz
+	}
+	out.Writer = bufio.NewWriter(w)
+	return out, nil
+}
+
+// Flush writes the buffered events, so they can be read while following.
+func (o *exportOutput) Flush() error {
+	if err := o.Writer.Flush(); err != nil {
+		return err
+	}
+	if o.gz != nil {
+		return o.gz.Flush()
+	}
+	return nil
+}
+
+// Close flushes the events and closes the file.
+func (o *exportOutput) Close() error {
+	if err := o.Writer.Flush(); err != nil {
+		return err
+	}
+	if o.gz != nil {
+		if err := o.gz.Close(); err != nil {
+			return err
+		}
+	}
+	if o.file == os.Stdout {
+		return nil
+	}
+	return o.file.Close()
+}
@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+func TestParseExportTime(t *testing.T) {
+	a := assert.New(t)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	from, err := parseExportTime("from", "2026-10-18T10:00:00+02:00", now)
+	a.NoError(err)
+	a.Equal(time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC), from)
+
+	from, err = parseExportTime("from", "2h", now)
+	a.NoError(err)
+	a.Equal(now.Add(-2*time.Hour), from)
+
+	for _, value := range []string{"", "yesterday", "-2h", "2026-10-18"} {
+		_, err = parseExportTime("from", value, now)
+		if a.Error(err, value) {
+			a.Contains(err.Error(), "--from")
+		}
+	}
+}
+
+func TestParseEventTypes(t *testing.T) {
+	a := assert.New(t)
+
+	types, err := parseEventTypes("")
+	a.NoError(err)
+	a.Equal(client.AllTypes, types)
+
+	types, err = parseEventTypes("FIREWALL, malware_protection")
+	a.NoError(err)
+	a.Equal([]client.EventType{client.FIREWALL, client.MALWARE_PROTECTION}, types)
+
+	_, err = parseEventTypes("firewall,firewal")
+	if a.Error(err) {
+		a.Contains(err.Error(), "'firewal'")
+	}
+}
+
+func TestExportRun(t *testing.T) {
+	a := assert.New(t)
+
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokens":
+			fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+		case "/sccs/v1/events/export":
+			var request map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&request)
+			requests = append(requests, request)
+			// Every other request returns the next page, then none.
+			if len(requests)%2 == 0 {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprintf(w, `[{"type_id":8031,"page":%d},{"type_id":8031,"page":%d}]`, len(requests), len(requests))
+		}
+	}))
+	defer server.Close()
+
+	sm := client.NewSymantecClient(server.URL, "customer", "domain", "id", "secret")
+	sm.Filters = map[client.EventType]client.Filter{client.FIREWALL: {Query: "type_id:8031"}}
+	from := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	request := exportRequest{
+		types:     []client.EventType{client.FIREWALL, client.MALWARE_PROTECTION},
+		from:      from,
+		to:        from.Add(2 * time.Hour),
+		batchSize: 1000,
+	}
+
+	dir, err := ioutil.TempDir("", "export")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.ndjson.gz")
+	out, err := openExportOutput(path, true)
+	a.NoError(err)
+	a.NoError(request.run(sm, out, make(chan struct{})))
+	a.NoError(out.Close())
+
+	if a.Len(requests, 4) {
+		a.Equal("FIREWALL", requests[0]["type"])
+		a.Equal("(type_id:8031)", requests[0]["query"])
+		a.Equal("2026-10-18T10:00:00Z", requests[0]["startDate"])
+		a.Equal("2026-10-18T12:00:00Z", requests[0]["endDate"])
+		a.Equal("MALWARE PROTECTION", requests[2]["type"])
+	}
+
+	file, err := os.Open(path)
+	a.NoError(err)
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	a.NoError(err)
+	var events []map[string]interface{}
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var event map[string]interface{}
+		a.NoError(json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	if a.Len(events, 4) {
+		a.Equal("FIREWALL", events[0]["event_type"])
+		a.Equal("MALWARE PROTECTION", events[3]["event_type"])
+	}
+}
+
+func TestExportOutputFile(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "export")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.ndjson")
+	out, err := openExportOutput(path, false)
+	a.NoError(err)
+	out.WriteString("{}\n")
+	a.NoError(out.Close())
+
+	content, err := ioutil.ReadFile(path)
+	a.NoError(err)
+	a.Equal("{}", strings.TrimSpace(string(content)))
+}
@@ -18,14 +18,80 @@
 package cmd
 
 import (
+	"flag"
+	"time"
+
 	"github.com/marian-craciunescu/symantecbeat/beater"
 
+	"github.com/elastic/beats/libbeat/beat"
 	cmd "github.com/elastic/beats/libbeat/cmd"
 	"github.com/elastic/beats/libbeat/cmd/instance"
+	"github.com/elastic/beats/libbeat/cmd/test"
+	"github.com/elastic/beats/libbeat/common"
 )
 
 // Name of this beat
 var Name = "symantecbeat"
 
 // RootCmd to handle beats cli
-var RootCmd = cmd.GenRootCmdWithSettings(beater.New, instance.Settings{Name: Name})
+var RootCmd = genRootCmd()
+
+func genRootCmd() *cmd.BeatsRootCmd {
+	settings := instance.Settings{Name: Name}
+	rootCmd := cmd.GenRootCmdWithSettings(beater.New, settings)
+
+	// --once is defined by the beater, like the --once of filebeat.
+	once := flag.CommandLine.Lookup("once")
+	rootCmd.RunCmd.Flags().AddGoFlag(once)
+	rootCmd.Flags().AddGoFlag(once)
+
+	// test api and export events check the configuration like test config,
+	// and run with it instead of creating the beater.
+	apiCmd := test.GenTestConfigCmd(settings, runWithConfig(testAPI))
+	apiCmd.Use = "api"
+	apiCmd.Short = "Test " + Name + " can log in to SES and export every event type"
+	rootCmd.TestCmd.AddCommand(apiCmd)
+
+	eventsCmd := test.GenTestConfigCmd(settings, runWithConfig(exportEvents))
+	eventsCmd.Use = "events"
+	eventsCmd.Short = "Export the SES events of a time range as NDJSON"
+	flags := eventsCmd.Flags()
+	exportFlags.types = flags.String("type", "", "Comma separated event types to export, e.g. firewall,malware_protection (default all)")
+	exportFlags.from = flags.String("from", "1h", "Start of the range, a RFC3339 time or a duration before now, e.g. 2h")
+	exportFlags.to = flags.String("to", "", "End of the range, a RFC3339 time or a duration before now (default now)")
+	exportFlags.query = flags.String("query", "", "SES query replacing the configured filters, e.g. type_id:8031")
+	exportFlags.tenant = flags.String("tenant", "", "Id of the tenant to export from (default the credentials of the beat)")
+	exportFlags.output = flags.String("output", "", "File to write the events to (default the standard output)")
+	exportFlags.gzip = flags.Bool("gzip", false, "Gzip the events")
+	exportFlags.follow = flags.Bool("follow", false, "Keep exporting the new events until interrupted")
+	rootCmd.ExportCmd.AddCommand(eventsCmd)
+
+	// backfill runs like the beat, with the backfill as beater.
+	backfillCmd := cmd.GenRootCmdWithSettings(runBackfill, settings).RunCmd
+	backfillCmd.Use = "backfill"
+	backfillCmd.Short = "Publish the SES events of a past range, exported in parallel chunks"
+	flags = backfillCmd.Flags()
+	backfillFlags.types = flags.String("type", "", "Comma separated event types to backfill, e.g. firewall,malware_protection (default all)")
+	backfillFlags.from = flags.String("from", "720h", "Start of the range, a RFC3339 time or a duration before now, e.g. 720h")
+	backfillFlags.to = flags.String("to", "", "End of the range, a RFC3339 time or a duration before now (default the start of the current chunk)")
+	backfillFlags.tenant = flags.String("tenant", "", "Id of the tenant to backfill (default the credentials of the beat)")
+	backfillFlags.state = flags.String("state", "", "File keeping the chunks done (default the backfill registry of the tenant)")
+	backfillFlags.chunk = flags.Duration("chunk", time.Hour, "Time window exported by a chunk")
+	backfillFlags.workers = flags.Int("workers", 4, "Number of chunks exported in parallel")
+	backfillFlags.rateLimit = flags.Float64("rate-limit", 0, "Maximum number of SES API requests per second (default the rate_limit of the tenant, or 5)")
+	rootCmd.AddCommand(backfillCmd)
+
+	return rootCmd
+}
+
+// runWithConfig returns a beat.Creator running run with the symantecbeat
+// section of the configuration, keystore included. The command exits once run
+// returns, with status 1 when it fails.
+func runWithConfig(run func(cfg *common.Config) error) beat.Creator {
+	return func(_ *beat.Beat, cfg *common.Config) (beat.Beater, error) {
+		if err := run(cfg); err != nil {
+			return nil, err
+		}
+		return nil, beat.GracefulExit
+	}
+}
@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestRunWithConfig(t *testing.T) {
+	a := assert.New(t)
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{"period": "1m"})
+	var period string
+	creator := runWithConfig(func(cfg *common.Config) error {
+		var err error
+		period, err = cfg.String("period", -1)
+		return err
+	})
+	beater, err := creator(nil, cfg)
+	a.Nil(beater)
+	a.Equal(beat.GracefulExit, err)
+	a.Equal("1m", period)
+
+	failed := errors.New("SES API test failed")
+	_, err = runWithConfig(func(*common.Config) error { return failed })(nil, cfg)
+	a.Equal(failed, err)
+}
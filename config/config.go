@@ -20,22 +20,463 @@
 
 package config
 
-import "time"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/paths"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/index"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// Collection modes of the SES events.
+const (
+	PollMode   = "poll"
+	StreamMode = "stream"
+)
+
+// Credentials are the SES API credentials of the beat or of a tenant.
+type Credentials struct {
+	ApiURL     string `config:"url"`
+	CustomerID string `config:"customer_id"`
+	DomainID   string `config:"domain_id"`
+	ClientID   string `config:"client_id"`
+	// ClientSecret can be read from the keystore or from an environment
+	// variable, e.g. ${SES_CLIENT_SECRET}, once at startup.
+	ClientSecret client.Secret `config:"client_secret"`
+	// ClientSecretFile holds the client secret instead, e.g. a file written
+	// by a vault agent.
+	ClientSecretFile string `config:"client_secret_file"`
+	// ClientSecretKey names the key of the keystore holding the client
+	// secret instead. Unlike ${key}, it is read again as the secret rotates.
+	ClientSecretKey string `config:"client_secret_key"`
+}
+
+// KeystorePath is the keystore ClientSecretKey is read from, the one of the
+// beat data path.
+var KeystorePath = func() string {
+	return paths.Resolve(paths.Data, "symantecbeat.keystore")
+}
+
+// Validate checks the url and that the client secret is set once.
+func (c *Credentials) Validate() error {
+	if c.ApiURL != "" {
+		u, err := url.Parse(c.ApiURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("url must be an absolute http or https URL, got '%s'", c.ApiURL)
+		}
+	}
+	set := 0
+	for _, secret := range []string{string(c.ClientSecret), c.ClientSecretFile, c.ClientSecretKey} {
+		if secret != "" {
+			set++
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("only one of client_secret, client_secret_file and client_secret_key can be set")
+	}
+	return nil
+}
+
+// validateRequired checks that the credentials are complete.
+func (c *Credentials) validateRequired() error {
+	var missing []string
+	if c.CustomerID == "" {
+		missing = append(missing, "customer_id")
+	}
+	if c.DomainID == "" {
+		missing = append(missing, "domain_id")
+	}
+	if c.ClientID == "" {
+		missing = append(missing, "client_id")
+	}
+	if !c.HasSecret() {
+		missing = append(missing, "client_secret, client_secret_file or client_secret_key")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s must be set to collect from SES", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// HasSecret tells whether the client secret is configured.
+func (c *Credentials) HasSecret() bool {
+	return c.ClientSecret != "" || c.ClientSecretFile != "" || c.ClientSecretKey != ""
+}
+
+// SecretSource returns the source the client secret is resolved from again
+// as it rotates, nil when it is set in the configuration.
+func (c *Credentials) SecretSource() client.SecretSource {
+	switch {
+	case c.ClientSecretFile != "":
+		return client.FileSecret(c.ClientSecretFile)
+	case c.ClientSecretKey != "":
+		return client.KeystoreSecret(KeystorePath(), c.ClientSecretKey)
+	default:
+		return nil
+	}
+}
+
+// Secret returns the client secret, resolved from its source when it has
+// one.
+func (c *Credentials) Secret() (client.Secret, error) {
+	if source := c.SecretSource(); source != nil {
+		return source()
+	}
+	return c.ClientSecret, nil
+}
+
+// NewClient creates a SES client with the given credentials, falling back to
+// the url of the beat, and the event settings of the beat.
+func (c *Config) NewClient(credentials Credentials) (client.SymantecClient, error) {
+	if credentials.ApiURL == "" {
+		credentials.ApiURL = c.ApiURL
+	}
+	secret, err := credentials.Secret()
+	if err != nil {
+		return client.SymantecClient{}, err
+	}
+	filters, err := client.NewFilters(c.Filters)
+	if err != nil {
+		return client.SymantecClient{}, err
+	}
+	sm := client.NewSymantecClient(credentials.ApiURL, credentials.CustomerID, credentials.DomainID, credentials.ClientID, secret)
+	sm.SecretSource = credentials.SecretSource()
+	sm.KeySanitization = c.KeySanitization
+	sm.PreserveOriginal = c.PreserveOriginal
+	sm.Filters = filters
+	return sm, nil
+}
+
+// ClientOf creates the SES client of the tenant with the given id, or of the
+// beat when id is empty.
+func (c *Config) ClientOf(id string) (client.SymantecClient, error) {
+	if id == "" {
+		if err := c.validateRequired(); err != nil {
+			return client.SymantecClient{}, err
+		}
+		return c.NewClient(c.Credentials)
+	}
+	t, ok := c.Tenant(id)
+	if !ok {
+		return client.SymantecClient{}, fmt.Errorf("no tenant %s is configured", id)
+	}
+	return c.TenantClient(t)
+}
+
+// Tenant returns the tenant with the given id.
+func (c *Config) Tenant(id string) (TenantConfig, bool) {
+	for _, t := range c.Tenants {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return TenantConfig{}, false
+}
+
+// TenantClient creates the SES client of a tenant, limited to its rate.
+func (c *Config) TenantClient(t TenantConfig) (client.SymantecClient, error) {
+	sm, err := c.NewClient(t.Credentials)
+	if err != nil {
+		return client.SymantecClient{}, fmt.Errorf("tenant %s: %v", t.ID, err)
+	}
+	sm.Limiter = client.NewLimiter(t.RateLimit)
+	return sm, nil
+}
 
 type Config struct {
-	Period       time.Duration `config:"period"`
-	ApiURL       string        `config:"url"`
-	CustomerID   string        `config:"customer_id"`
-	DomainID     string        `config:"domain_id"`
-	ClientID     string        `config:"client_id"`
-	ClientSecret string        `config:"client_secret"`
-	BatchSize    int           `config:"batch_size"`
-	StartDate    time.Duration `config:"start_date"`
+	Mode        string        `config:"mode"`
+	Period      time.Duration `config:"period"`
+	Credentials `config:",inline"`
+	BatchSize   int           `config:"batch_size"`
+	StartDate   time.Duration `config:"start_date"`
+
+	KeySanitization  client.KeySanitization `config:"key_sanitization"`
+	PreserveOriginal bool                   `config:"preserve_original"`
+	// DeviceTimestamp sets @timestamp to the time the event occurred on the
+	// device instead of the time it was collected.
+	DeviceTimestamp bool `config:"device_timestamp"`
+
+	IngestPipelines    bool `config:"ingest_pipelines"`
+	OverwritePipelines bool `config:"overwrite_pipelines"`
+
+	Index index.Config `config:"index"`
+
+	// Filters are keyed by event type name, e.g. malware_protection.
+	Filters map[string]client.Filter `config:"filters"`
+
+	// Inputs configure the collectors, each with a type such as ses_events
+	// or sepm. Without inputs, they are read from the mode and the sections
+	// of the collectors, e.g. sepm.
+	Inputs []*common.Config `config:"inputs"`
+
+	// Tenants are SES tenants collected next to the inputs, each with its
+	// own credentials and inputs.
+	Tenants []TenantConfig `config:"tenants"`
+
+	// ConfigInputs loads more inputs and tenants from files, e.g.
+	// inputs.d/*.yml, and reloads them as the files change.
+	ConfigInputs *common.Config `config:"config.inputs"`
+
+	// RunOnce collects from the checkpoints up to EndDate and exits, e.g.
+	// when the beat is run by a scheduler.
+	RunOnce bool `config:"run_once"`
+	// EndDate is a RFC3339 time, now when it is empty.
+	EndDate string `config:"end_date"`
+}
+
+// TenantConfig configures the collection from one SES tenant.
+type TenantConfig struct {
+	// ID is published in organization.id and namespaces the registries of
+	// the tenant inputs.
+	ID          string `config:"id"`
+	Credentials `config:",inline"`
+	// RateLimit is the maximum number of SES API requests per second made
+	// by all the inputs of the tenant. 0 does not limit them.
+	RateLimit float64  `config:"rate_limit"`
+	Tags      []string `config:"tags"`
+	// Inputs default to the export of the SES events.
+	Inputs []*common.Config `config:"inputs"`
+}
+
+// Validate checks that the tenant can be told apart and can log in.
+func (t *TenantConfig) Validate() error {
+	if t.ID == "" {
+		return fmt.Errorf("every tenant needs an id")
+	}
+	if err := registry.CheckID(t.ID); err != nil {
+		return fmt.Errorf("tenant: %v", err)
+	}
+	if err := t.validateRequired(); err != nil {
+		return fmt.Errorf("tenant %s: %v", t.ID, err)
+	}
+	if t.RateLimit < 0 {
+		return fmt.Errorf("tenant %s: rate_limit cannot be negative, got %v", t.ID, t.RateLimit)
+	}
+	return nil
+}
+
+// sesInputTypes are the input types collecting from SES.
+var sesInputTypes = map[string]bool{
+	"ses_events": true,
+	"ses_stream": true,
+	"devices":    true,
+	"incidents":  true,
+}
+
+// Validate checks the collection settings, the credentials when the SES
+// events are collected with them, and that the tenant ids are unique.
+func (c *Config) Validate() error {
+	if c.Mode != PollMode && c.Mode != StreamMode {
+		return fmt.Errorf("mode must be %s or %s, got '%s'", PollMode, StreamMode, c.Mode)
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > client.MaxBatchSize {
+		return fmt.Errorf("batch_size must be between 1 and %d, got %d", client.MaxBatchSize, c.BatchSize)
+	}
+	if c.StartDate < 0 {
+		return fmt.Errorf("start_date cannot be negative, got %v", c.StartDate)
+	}
+	if c.EndDate != "" {
+		if _, err := time.Parse(time.RFC3339, c.EndDate); err != nil {
+			return fmt.Errorf("end_date must be a RFC3339 time, e.g. 2006-01-02T15:04:05Z, got '%s'", c.EndDate)
+		}
+	}
+
+	usesCredentials, err := c.UsesCredentials()
+	if err != nil {
+		return err
+	}
+	if usesCredentials {
+		if err := c.validateRequired(); err != nil {
+			return err
+		}
+	}
+
+	ids := map[string]bool{}
+	for _, t := range c.Tenants {
+		if ids[t.ID] {
+			return fmt.Errorf("tenant %s is configured twice", t.ID)
+		}
+		ids[t.ID] = true
+	}
+	return nil
+}
+
+// End returns the end of the collection in run once mode, EndDate or now.
+func (c *Config) End(now time.Time) time.Time {
+	if end, err := time.Parse(time.RFC3339, c.EndDate); err == nil {
+		return end.UTC()
+	}
+	return now
+}
+
+// UsesCredentials tells whether inputs collect from SES with the credentials
+// of the beat, the ones not configured for a tenant.
+func (c *Config) UsesCredentials() (bool, error) {
+	if len(c.Inputs) == 0 {
+		return len(c.Tenants) == 0, nil
+	}
+	for _, cfg := range c.Inputs {
+		var in struct {
+			Type string `config:"type"`
+		}
+		if err := cfg.Unpack(&in); err != nil {
+			return false, err
+		}
+		if sesInputTypes[in.Type] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// TenantType is the type of the entries of the config.inputs files that
+// configure a tenant instead of an input.
+const TenantType = "tenant"
+
+// TenantInputConfigs returns the configuration of the inputs of a tenant.
+func TenantInputConfigs(t TenantConfig) ([]*common.Config, error) {
+	if len(t.Inputs) > 0 {
+		return t.Inputs, nil
+	}
+	cfg, err := common.NewConfigFrom(common.MapStr{"type": "ses_events"})
+	if err != nil {
+		return nil, err
+	}
+	return []*common.Config{cfg}, nil
 }
 
 var DefaultConfig = Config{
+	Mode:      PollMode,
 	Period:    5 * time.Minute,
 	StartDate: 60 * time.Minute,
 	BatchSize: 1000,
-	ApiURL:    "https://usea1.r3.securitycloud.symantec.com/r3_epmp_i",
+	Credentials: Credentials{
+		ApiURL: "https://usea1.r3.securitycloud.symantec.com/r3_epmp_i",
+	},
+
+	KeySanitization: client.KeepKeys,
+	Index:           index.DefaultConfig,
+}
+
+type collectorSection struct {
+	name, inputType string
+}
+
+// sections maps the configuration sections of the collectors to their input
+// type.
+var sections = []collectorSection{
+	{"devices", "devices"},
+	{"incidents", "incidents"},
+	{"sepm", "sepm"},
+	{"sepm.syslog", "sepm_syslog"},
+	{"wss", "wss"},
+	{"email_security", "email_security"},
+	{"dlp", "dlp"},
+	{"edr", "edr"},
+	{"icdx", "icdx"},
+}
+
+// InputConfigs returns the configuration of every input. Without an inputs
+// list, an input is created for every enabled section of raw, the beat
+// configuration, and unless tenants are configured the SES events are
+// collected according to the mode.
+func (c *Config) InputConfigs(raw *common.Config) ([]*common.Config, error) {
+	if len(c.Inputs) > 0 {
+		return c.Inputs, nil
+	}
+
+	var inputs []*common.Config
+	switch {
+	case len(c.Tenants) > 0:
+	case c.Mode == PollMode:
+		cfg, err := common.NewConfigFrom(common.MapStr{
+			"type":       "ses_events",
+			"period":     c.Period.String(),
+			"batch_size": c.BatchSize,
+			"start_date": c.StartDate.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, cfg)
+	case c.Mode == StreamMode:
+		// The offsets stay in the stream registry, and stream.id becomes
+		// stream_id since id names the input.
+		cfg, err := sectionInput(raw, "stream", "ses_stream")
+		if err != nil {
+			return nil, err
+		}
+		var stream struct {
+			ID string `config:"id"`
+		}
+		if err := cfg.Unpack(&stream); err != nil {
+			return nil, err
+		}
+		if stream.ID == "" {
+			return nil, fmt.Errorf("stream.id is required in stream mode")
+		}
+		if err := cfg.SetString("stream_id", -1, stream.ID); err != nil {
+			return nil, err
+		}
+		if err := cfg.SetString("id", -1, "stream"); err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, cfg)
+	default:
+		return nil, fmt.Errorf("unknown mode '%s', expected poll or stream", c.Mode)
+	}
+
+	for _, section := range sections {
+		if !hasSection(raw, section.name) {
+			continue
+		}
+		cfg, err := sectionInput(raw, section.name, section.inputType)
+		if err != nil {
+			return nil, err
+		}
+		var enabled struct {
+			Enabled bool `config:"enabled"`
+		}
+		if err := cfg.Unpack(&enabled); err != nil {
+			return nil, err
+		}
+		if enabled.Enabled {
+			inputs = append(inputs, cfg)
+		}
+	}
+	return inputs, nil
+}
+
+// sectionInput returns the input of the given type configured by the section
+// name of raw.
+func sectionInput(raw *common.Config, name, inputType string) (*common.Config, error) {
+	cfg, err := common.NewConfigFrom(common.MapStr{"type": inputType})
+	if err != nil {
+		return nil, err
+	}
+	if !hasSection(raw, name) {
+		return cfg, nil
+	}
+	section, err := raw.Child(name, -1)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Merge(section); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func hasSection(raw *common.Config, name string) bool {
+	ok, err := raw.Has(name, -1)
+	return err == nil && ok
 }
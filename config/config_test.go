@@ -18,3 +18,317 @@
 // +build !integration
 
 package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	_ "github.com/marian-craciunescu/symantecbeat/email"
+	_ "github.com/marian-craciunescu/symantecbeat/sepm"
+	_ "github.com/marian-craciunescu/symantecbeat/stream"
+)
+
+// credentials are complete SES credentials.
+var credentials = common.MapStr{
+	"customer_id":   "customer",
+	"domain_id":     "domain",
+	"client_id":     "id",
+	"client_secret": "secret",
+}
+
+func inputs(t *testing.T, raw common.MapStr) []common.MapStr {
+	cfg := common.MustNewConfigFrom(credentials)
+	assert.NoError(t, cfg.Merge(raw))
+	c := DefaultConfig
+	if !assert.NoError(t, cfg.Unpack(&c)) {
+		return nil
+	}
+	configs, err := c.InputConfigs(cfg)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+
+	var inputs []common.MapStr
+	for _, config := range configs {
+		var m common.MapStr
+		assert.NoError(t, config.Unpack(&m))
+		inputs = append(inputs, m)
+	}
+	return inputs
+}
+
+func TestInputConfigs(t *testing.T) {
+	a := assert.New(t)
+
+	found := inputs(t, common.MapStr{
+		"inputs": []common.MapStr{
+			{"type": "ses_events"},
+			{"type": "sepm", "url": "https://sepm:8446"},
+		},
+		"sepm.enabled": true,
+	})
+	if a.Len(found, 2) {
+		a.Equal("sepm", found[1]["type"])
+	}
+}
+
+func TestLegacyInputConfigs(t *testing.T) {
+	a := assert.New(t)
+
+	found := inputs(t, common.MapStr{
+		"period":              "1m",
+		"devices.enabled":     true,
+		"incidents.enabled":   false,
+		"sepm.syslog.enabled": true,
+		"sepm.syslog.host":    "0.0.0.0:514",
+		"edr": common.MapStr{
+			"enabled":    true,
+			"appliances": []common.MapStr{{"url": "https://edr01"}},
+		},
+	})
+	if !a.Len(found, 4) {
+		return
+	}
+	a.Equal("ses_events", found[0]["type"])
+	a.Equal("1m0s", found[0]["period"])
+	a.Equal("devices", found[1]["type"])
+	a.Equal("sepm_syslog", found[2]["type"])
+	a.Equal("0.0.0.0:514", found[2]["host"])
+	a.Equal("edr", found[3]["type"])
+
+	found = inputs(t, common.MapStr{
+		"mode":      StreamMode,
+		"stream.id": "s1",
+	})
+	if a.Len(found, 1) {
+		a.Equal("ses_stream", found[0]["type"])
+		a.Equal("s1", found[0]["stream_id"])
+		a.Equal("stream", found[0]["id"])
+	}
+
+	cfg := common.MustNewConfigFrom(credentials)
+	c := DefaultConfig
+	a.NoError(cfg.Unpack(&c))
+	c.Mode = StreamMode
+	_, err := c.InputConfigs(cfg)
+	a.Error(err)
+
+	c.Mode = "push"
+	_, err = c.InputConfigs(cfg)
+	a.Error(err)
+}
+
+func TestTenants(t *testing.T) {
+	a := assert.New(t)
+
+	tenant := credentials.Clone()
+	tenant["id"] = "acme"
+	cfg := common.MustNewConfigFrom(common.MapStr{
+		"tenants":         []common.MapStr{tenant},
+		"devices.enabled": true,
+	})
+	c := DefaultConfig
+	a.NoError(cfg.Unpack(&c))
+
+	// The SES events of the tenants replace the ones of the mode.
+	found, err := c.InputConfigs(cfg)
+	a.NoError(err)
+	a.Len(found, 1)
+
+	found, err = TenantInputConfigs(c.Tenants[0])
+	a.NoError(err)
+	if a.Len(found, 1) {
+		inputType, _ := found[0].String("type", -1)
+		a.Equal("ses_events", inputType)
+	}
+
+	c = DefaultConfig
+	cfg = common.MustNewConfigFrom(common.MapStr{"tenants": []common.MapStr{tenant, tenant}})
+	a.Error(cfg.Unpack(&c))
+
+	c = DefaultConfig
+	cfg = common.MustNewConfigFrom(common.MapStr{"tenants": []common.MapStr{{"id": "acme"}}})
+	a.Error(cfg.Unpack(&c))
+
+	// The tenant id names a registry directory.
+	escaping := tenant.Clone()
+	escaping["id"] = "../x"
+	c = DefaultConfig
+	cfg = common.MustNewConfigFrom(common.MapStr{"tenants": []common.MapStr{escaping}})
+	a.Error(cfg.Unpack(&c))
+}
+
+func TestCredentials(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "config")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "client_secret")
+	a.NoError(ioutil.WriteFile(path, []byte("from-file\n"), 0600))
+
+	c := DefaultConfig
+	a.NoError(common.MustNewConfigFrom(common.MapStr{
+		"client_id":          "id",
+		"client_secret_file": path,
+		"tenants": []common.MapStr{
+			{"id": "acme", "customer_id": "customer", "domain_id": "domain", "client_id": "id", "client_secret": "s3cr3t"},
+		},
+	}).Unpack(&c))
+	secret, err := c.Secret()
+	a.NoError(err)
+	a.Equal("from-file", string(secret))
+	secret, err = c.Tenants[0].Secret()
+	a.NoError(err)
+	a.Equal("s3cr3t", string(secret))
+
+	// The beat logs its configuration.
+	a.NotContains(fmt.Sprintf("%+v", c), "s3cr3t")
+
+	c = DefaultConfig
+	a.Error(common.MustNewConfigFrom(common.MapStr{
+		"client_secret":      "s3cr3t",
+		"client_secret_file": path,
+	}).Unpack(&c))
+	c = DefaultConfig
+	a.Error(common.MustNewConfigFrom(common.MapStr{
+		"client_secret_file": path,
+		"client_secret_key":  "SES_CLIENT_SECRET",
+	}).Unpack(&c))
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		key      string
+		settings common.MapStr
+	}{
+		{"mode", common.MapStr{"mode": "push"}},
+		{"period", common.MapStr{"period": "0s"}},
+		{"batch_size", common.MapStr{"batch_size": 0}},
+		{"batch_size", common.MapStr{"batch_size": 10001}},
+		{"start_date", common.MapStr{"start_date": "-1h"}},
+		{"url", common.MapStr{"url": "usea1.r3.securitycloud.symantec.com"}},
+		{"customer_id", common.MapStr{"customer_id": ""}},
+		{"end_date", common.MapStr{"end_date": "yesterday"}},
+		// The sections and inputs of the collectors are validated too.
+		{"sepm.period", common.MapStr{"sepm": common.MapStr{
+			"enabled": true, "url": "https://sepm:8446", "username": "admin", "password": "secret", "period": "0s",
+		}}},
+		{"sepm.batch_size", common.MapStr{"inputs": []common.MapStr{{
+			"type": "sepm", "url": "https://sepm:8446", "username": "admin", "password": "secret", "batch_size": 0,
+		}}}},
+	} {
+		cfg := common.MustNewConfigFrom(credentials)
+		assert.NoError(t, cfg.Merge(test.settings))
+		_, err := Unpack(cfg)
+		if assert.Error(t, err, test.settings.String()) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+
+	// Inputs not collecting from SES do not need the SES credentials.
+	_, err := Unpack(common.MustNewConfigFrom(common.MapStr{
+		"inputs": []common.MapStr{{"type": "sepm", "url": "https://sepm:8446", "username": "admin", "password": "secret"}},
+	}))
+	assert.NoError(t, err)
+
+	c, err := Unpack(common.MustNewConfigFrom(credentials))
+	assert.NoError(t, err)
+	now := time.Now().UTC()
+	assert.Equal(t, now, c.End(now))
+	c.EndDate = "2026-10-19T02:00:00+02:00"
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), c.End(now))
+}
+
+func TestUnknownKeys(t *testing.T) {
+	a := assert.New(t)
+
+	cfg := common.MustNewConfigFrom(common.MapStr{
+		"customer_id":    "customer",
+		"domain_id":      "domain",
+		"client_id":      "id",
+		"client_sercret": "secret",
+	})
+	_, err := Unpack(cfg)
+	if a.Error(err) {
+		a.Contains(err.Error(), "'client_sercret'")
+		a.Contains(err.Error(), "did you mean 'client_secret'")
+	}
+
+	cfg = common.MustNewConfigFrom(credentials)
+	a.NoError(cfg.Merge(common.MapStr{"unrelated": true}))
+	_, err = Unpack(cfg)
+	if a.Error(err) {
+		a.NotContains(err.Error(), "did you mean")
+	}
+
+	cfg = common.MustNewConfigFrom(credentials)
+	a.NoError(cfg.Merge(common.MapStr{
+		"sepm.syslog.enabled":   false,
+		"stream.id":             "s1",
+		"config.inputs.enabled": false,
+		"index.enabled":         false,
+		"sepm.password":         "secret",
+		"sepm.ssl.enabled":      false,
+		"tenants": []common.MapStr{{
+			"id": "acme", "customer_id": "customer", "domain_id": "domain", "client_id": "id", "client_secret": "secret",
+			"inputs": []common.MapStr{{"type": "sepm", "id": "sepm-acme", "url": "https://sepm:8446", "username": "admin", "password": "secret"}},
+		}},
+	}))
+	_, err = Unpack(cfg)
+	a.NoError(err)
+
+	// The collector sections, tenants and inputs are checked too.
+	for _, test := range []struct {
+		settings common.MapStr
+		message  string
+	}{
+		{
+			common.MapStr{"sepm.pasword": "secret"},
+			"unknown setting 'pasword' in symantecbeat.sepm, did you mean 'password'?",
+		},
+		{
+			common.MapStr{"sepm.syslog.hots": "localhost:6514"},
+			"unknown setting 'hots' in symantecbeat.sepm.syslog, did you mean 'host'?",
+		},
+		{
+			common.MapStr{"sepm.ssl.verfication_mode": "none"},
+			"unknown setting 'verfication_mode' in symantecbeat.sepm.ssl, did you mean 'verification_mode'?",
+		},
+		{
+			common.MapStr{"email_security.backoff.maximum": "1m"},
+			"unknown setting 'maximum' in symantecbeat.email_security.backoff",
+		},
+		{
+			common.MapStr{"stream.chanel": "1"},
+			"unknown setting 'chanel' in symantecbeat.stream, did you mean 'channel'?",
+		},
+		{
+			common.MapStr{"tenants": []common.MapStr{{"id": "acme", "client_sercret": "secret"}}},
+			"unknown setting 'client_sercret' in symantecbeat.tenants.0, did you mean 'client_secret'?",
+		},
+		{
+			common.MapStr{"tenants": []common.MapStr{{"id": "acme", "inputs": []common.MapStr{{"type": "sepm", "usename": "admin"}}}}},
+			"unknown setting 'usename' in symantecbeat.tenants.0.inputs.0, did you mean 'username'?",
+		},
+		{
+			common.MapStr{"inputs": []common.MapStr{{"type": "email_security", "feed": []string{"all"}}}},
+			"unknown setting 'feed' in symantecbeat.inputs.0, did you mean 'feeds'?",
+		},
+	} {
+		cfg = common.MustNewConfigFrom(credentials)
+		a.NoError(cfg.Merge(test.settings))
+		_, err = Unpack(cfg)
+		if a.Error(err, test.message) {
+			a.Equal(test.message, err.Error())
+		}
+	}
+}
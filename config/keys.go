@@ -0,0 +1,327 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/input"
+)
+
+// Unpack reads the symantecbeat section of the configuration and validates
+// it. Unknown settings are rejected, with a suggestion when they look like a
+// typo of a known one.
+func Unpack(cfg *common.Config) (Config, error) {
+	if err := checkKeys(cfg); err != nil {
+		return Config{}, err
+	}
+	c := DefaultConfig
+	if err := cfg.Unpack(&c); err != nil {
+		return Config{}, err
+	}
+	return c, nil
+}
+
+var (
+	configType = reflect.TypeOf(common.Config{})
+	inputsType = reflect.TypeOf([]*common.Config{})
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// checkKeys rejects the settings of cfg that are neither fields of Config
+// nor sections of the collectors. The sections of the collectors, the
+// tenants and the inputs are checked against the settings of their input
+// type.
+func checkKeys(cfg *common.Config) error {
+	known := map[string]bool{"stream": true}
+	for _, section := range sections {
+		known[strings.SplitN(section.name, ".", 2)[0]] = true
+	}
+	if err := checkStruct(cfg, "", reflect.TypeOf(Config{}), known); err != nil {
+		return err
+	}
+
+	for _, section := range append([]collectorSection{{"stream", "ses_stream"}}, sections...) {
+		if !hasSection(cfg, section.name) {
+			continue
+		}
+		child, err := cfg.Child(section.name, -1)
+		if err != nil {
+			return err
+		}
+		if err := checkInput(child, section.name, section.inputType, subsections(section.name), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckEntryKeys rejects the unknown settings of an entry of the
+// config.inputs files, an input or a tenant.
+func CheckEntryKeys(cfg *common.Config) error {
+	entryType, _ := cfg.String("type", -1)
+	if entryType == TenantType {
+		return checkStruct(cfg, "config.inputs", reflect.TypeOf(TenantConfig{}), map[string]bool{"type": true})
+	}
+	return checkInput(cfg, "config.inputs", entryType, nil, true)
+}
+
+// subsections returns the sections of the collectors nested in the section
+// name, e.g. syslog in sepm.
+func subsections(name string) map[string]bool {
+	nested := map[string]bool{}
+	for _, section := range sections {
+		if strings.HasPrefix(section.name, name+".") {
+			nested[strings.SplitN(strings.TrimPrefix(section.name, name+"."), ".", 2)[0]] = true
+		}
+	}
+	return nested
+}
+
+// checkInput checks the settings of an input of the given type at path, and
+// validates them like its factory does. Unlike the sections of the
+// collectors, the inputs are enabled by default. Inputs of a type without
+// registered settings are left to their factory.
+func checkInput(cfg *common.Config, path, inputType string, nested map[string]bool, enabled bool) error {
+	settings := input.GetSettings(inputType)
+	if settings == nil {
+		return nil
+	}
+	shared := map[string]reflect.Type{}
+	addKeys(shared, reflect.TypeOf(input.Config{}))
+	known := map[string]bool{}
+	for key := range shared {
+		known[key] = true
+	}
+	for key := range nested {
+		known[key] = true
+	}
+	if err := checkStruct(cfg, path, reflect.TypeOf(settings), known); err != nil {
+		return err
+	}
+
+	config := reflect.New(reflect.TypeOf(settings))
+	config.Elem().Set(reflect.ValueOf(settings))
+	if field := config.Elem().FieldByName("Enabled"); enabled && field.Kind() == reflect.Bool {
+		field.SetBool(true)
+	}
+	return cfg.Unpack(config.Interface())
+}
+
+// checkInputs checks the inputs listed under key, each against the settings
+// of its type.
+func checkInputs(cfg *common.Config, path, key string) error {
+	n, err := cfg.CountField(key)
+	if err != nil {
+		return nil
+	}
+	for i := 0; i < n; i++ {
+		child, err := cfg.Child(key, i)
+		if err != nil {
+			continue
+		}
+		inputType, _ := child.String("type", -1)
+		if err := checkInput(child, fmt.Sprintf("%s.%d", path, i), inputType, nil, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStruct rejects the settings of cfg that are not fields of t nor in
+// extra, and checks the nested sections of t.
+func checkStruct(cfg *common.Config, path string, t reflect.Type, extra map[string]bool) error {
+	fields := map[string]reflect.Type{}
+	addKeys(fields, t)
+	known := map[string]bool{}
+	for key := range fields {
+		known[key] = true
+	}
+	for key := range extra {
+		known[key] = true
+	}
+
+	for _, key := range cfg.GetFields() {
+		fieldType, ok := fields[key]
+		if !ok {
+			if extra[key] {
+				continue
+			}
+			return unknownKey(key, path, known)
+		}
+		if err := checkField(cfg, join(path, key), key, fieldType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkField checks the setting key of cfg when it is a section, or a list
+// of sections or inputs. The settings that do not unpack are left to Unpack
+// to report.
+func checkField(cfg *common.Config, path, key string, t reflect.Type) error {
+	if t == nil {
+		return nil
+	}
+	if t == inputsType {
+		return checkInputs(cfg, path, key)
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Struct:
+		if !isSection(t) {
+			return nil
+		}
+		child, err := cfg.Child(key, -1)
+		if err != nil {
+			return nil
+		}
+		return checkStruct(child, path, indirect(t), nil)
+	case reflect.Slice:
+		if !isSection(t.Elem()) {
+			return nil
+		}
+		n, err := cfg.CountField(key)
+		if err != nil {
+			return nil
+		}
+		for i := 0; i < n; i++ {
+			child, err := cfg.Child(key, i)
+			if err != nil {
+				continue
+			}
+			if err := checkStruct(child, fmt.Sprintf("%s.%d", path, i), indirect(t.Elem()), nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isSection tells whether t is unpacked field by field from a section of
+// the configuration, and so can be checked.
+func isSection(t reflect.Type) bool {
+	t = indirect(t)
+	if t.Kind() != reflect.Struct || t == configType || t == timeType {
+		return false
+	}
+	_, unpacker := reflect.PtrTo(t).MethodByName("Unpack")
+	return !unpacker
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func unknownKey(key, path string, known map[string]bool) error {
+	where := "the symantecbeat section"
+	if path != "" {
+		where = "symantecbeat." + path
+	}
+	if suggestion := closest(key, known); suggestion != "" {
+		return fmt.Errorf("unknown setting '%s' in %s, did you mean '%s'?", key, where, suggestion)
+	}
+	return fmt.Errorf("unknown setting '%s' in %s", key, where)
+}
+
+// addKeys maps the settings of the struct t to the types of their fields.
+// The dotted settings, e.g. config.inputs, are known by their first part and
+// not checked further.
+func addKeys(fields map[string]reflect.Type, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("config")
+		if tag == "" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if name == "" && strings.Contains(tag, ",inline") {
+			addKeys(fields, indirect(field.Type))
+			continue
+		}
+		if strings.Contains(name, ".") {
+			fields[strings.SplitN(name, ".", 2)[0]] = nil
+			continue
+		}
+		fields[name] = field.Type
+	}
+}
+
+// closest returns the known key nearest to key, if it is close enough to be
+// a typo.
+func closest(key string, known map[string]bool) string {
+	candidates := make([]string, 0, len(known))
+	for k := range known {
+		candidates = append(candidates, k)
+	}
+	sort.Strings(candidates)
+
+	best, bestDistance := "", 3
+	for _, k := range candidates {
+		if d := distance(key, k); d < bestDistance {
+			best, bestDistance = k, d
+		}
+	}
+	return best
+}
+
+// distance is the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minimum(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minimum(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,228 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dlp
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+const (
+	incidentsURL = "/ProtectManager/webservices/v2/incidents"
+
+	dlpTimeFormat = "2006-01-02T15:04:05.999"
+)
+
+// Client talks to the incident REST API of a DLP Enforce server.
+type Client struct {
+	URL      string
+	Username string
+	Password client.Secret
+
+	httpClient *http.Client
+	logger     *logp.Logger
+}
+
+// NewClient creates a DLP client. tlsConfig may be nil to use the system
+// defaults.
+func NewClient(url, username string, password client.Secret, tlsConfig *tls.Config) *Client {
+	return &Client{
+		URL:        url,
+		Username:   username,
+		Password:   password,
+		httpClient: client.NewHTTPClient(tlsConfig),
+		logger:     logp.NewLogger("dlp_client"),
+	}
+}
+
+// Filter is a filter of the incident list request, e.g.
+//
+//	{"filterType": "long", "operandOne": {"name": "incidentId"},
+//	 "operator": "GT", "operandTwoValues": [100]}
+type Filter map[string]interface{}
+
+func fieldFilter(filterType, field, operator string, value interface{}) Filter {
+	return Filter{
+		"filterType":       filterType,
+		"operandOne":       map[string]string{"name": field},
+		"operator":         operator,
+		"operandTwoValues": []interface{}{value},
+	}
+}
+
+func andFilter(filters ...Filter) Filter {
+	return Filter{
+		"filterType":      "booleanLogic",
+		"booleanOperator": "AND",
+		"filters":         filters,
+	}
+}
+
+type field struct {
+	Name string `json:"name"`
+}
+
+type orderBy struct {
+	Order string `json:"order"`
+	Field field  `json:"field"`
+}
+
+type page struct {
+	Type       string `json:"type"`
+	PageNumber int    `json:"pageNumber"`
+	PageSize   int    `json:"pageSize"`
+}
+
+type listRequest struct {
+	SavedReportID int       `json:"savedReportId,omitempty"`
+	Select        []field   `json:"select"`
+	Filter        Filter    `json:"filter,omitempty"`
+	OrderBy       []orderBy `json:"orderBy"`
+	Page          page      `json:"page"`
+}
+
+// IncidentRef identifies an incident of the incident list.
+type IncidentRef struct {
+	ID           int64  `json:"incidentId"`
+	CreationDate string `json:"creationDate"`
+}
+
+// Created parses the creation date of the incident.
+func (r IncidentRef) Created() (time.Time, error) {
+	return time.Parse(dlpTimeFormat, r.CreationDate)
+}
+
+type listResponse struct {
+	TotalCount int           `json:"totalCount"`
+	Incidents  []IncidentRef `json:"incidents"`
+}
+
+// ListIncidents pages through the incidents of the saved report, or of all
+// the incidents when savedReportID is 0, matching the filter, in the order
+// they were created.
+func (c *Client) ListIncidents(savedReportID int, filter Filter, pageSize int) ([]IncidentRef, error) {
+	request := listRequest{
+		SavedReportID: savedReportID,
+		Select:        []field{{"incidentId"}, {"creationDate"}},
+		Filter:        filter,
+		OrderBy:       []orderBy{{Order: "ASC", Field: field{"incidentId"}}},
+		Page:          page{Type: "offset", PageSize: pageSize},
+	}
+
+	var incidents []IncidentRef
+	for number := 1; ; number++ {
+		request.Page.PageNumber = number
+		body, err := json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+
+		var response listResponse
+		if err := c.do(http.MethodPost, incidentsURL, bytes.NewReader(body), &response); err != nil {
+			return nil, err
+		}
+		incidents = append(incidents, response.Incidents...)
+
+		if len(response.Incidents) < pageSize || len(incidents) >= response.TotalCount {
+			break
+		}
+	}
+	return incidents, nil
+}
+
+// Incident holds the details of an incident.
+type Incident struct {
+	StaticAttributes   map[string]interface{}
+	EditableAttributes map[string]interface{}
+	Components         []Component
+}
+
+// Component is a message component, e.g. the body or an attachment, and the
+// number of policy matches it contains.
+type Component struct {
+	ID         int64  `json:"messageComponentId"`
+	Name       string `json:"name"`
+	Type       string `json:"messageComponentType"`
+	MatchCount int    `json:"matchCount"`
+}
+
+type attributesResponse struct {
+	InfoMap map[string]interface{} `json:"infoMap"`
+}
+
+// GetIncident fetches the static and editable attributes and the matched
+// components of an incident.
+func (c *Client) GetIncident(id int64) (Incident, error) {
+	var incident Incident
+	path := fmt.Sprintf("%s/%d", incidentsURL, id)
+
+	var static, editable attributesResponse
+	if err := c.do(http.MethodGet, path+"/staticAttributes", nil, &static); err != nil {
+		return incident, err
+	}
+	if err := c.do(http.MethodGet, path+"/editableAttributes", nil, &editable); err != nil {
+		return incident, err
+	}
+	if err := c.do(http.MethodGet, path+"/components", nil, &incident.Components); err != nil {
+		return incident, err
+	}
+	incident.StaticAttributes = static.InfoMap
+	incident.EditableAttributes = editable.InfoMap
+	return incident, nil
+}
+
+// do sends a request authenticated with basic auth and decodes the response
+// into v. Responses other than 200 OK are returned as errors.
+func (c *Client) do(method, path string, body io.Reader, v interface{}) error {
+	req, err := http.NewRequest(method, c.URL+path, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, string(c.Password))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	c.logger.Debugf("Server response=%d", resp.StatusCode)
+	response, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s returned %s", method, path, resp.Status)
+	}
+	if err := json.Unmarshal(response, v); err != nil {
+		return fmt.Errorf("error decoding %s response: %v", path, err)
+	}
+	return nil
+}
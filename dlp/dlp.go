@@ -0,0 +1,315 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dlp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// MaxBatchSize is the largest page size requested from the DLP incident list.
+const MaxBatchSize = 1000
+
+const (
+	// EventType is the event_type of the DLP incident documents.
+	EventType = "DLP INCIDENT"
+
+	productName = "Symantec Data Loss Prevention"
+)
+
+// severities maps the DLP severities to the SES severity_id.
+var severities = map[int]struct {
+	name string
+	id   int
+}{
+	1: {"HIGH", 4},
+	2: {"MEDIUM", 3},
+	3: {"LOW", 2},
+	4: {"INFO", 1},
+}
+
+// Config configures the DLP incidents collector.
+type Config struct {
+	Enabled  bool              `config:"enabled"`
+	URL      string            `config:"url"`
+	Username string            `config:"username"`
+	Password client.Secret     `config:"password"`
+	SSL      *tlscommon.Config `config:"ssl"`
+	Period   time.Duration     `config:"period"`
+	// BatchSize is the page size of the incident list.
+	BatchSize int `config:"batch_size"`
+	// StartDate is how far back to look for incidents on the first run.
+	StartDate time.Duration `config:"start_date"`
+	// SavedReportIDs lists the incidents of these saved reports. All the
+	// incidents are listed when empty.
+	SavedReportIDs []int `config:"saved_report_ids"`
+	// Filter is an incident list filter added to the checkpoint filter.
+	Filter Filter `config:"filter"`
+}
+
+// DefaultConfig polls the incidents every 5 minutes.
+var DefaultConfig = Config{
+	Period:    5 * time.Minute,
+	BatchSize: 100,
+	StartDate: 24 * time.Hour,
+}
+
+// Validate checks that an enabled collector knows where and how to log in.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("dlp.period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > MaxBatchSize {
+		return fmt.Errorf("dlp.batch_size must be between 1 and %d, got %d", MaxBatchSize, c.BatchSize)
+	}
+	if c.URL == "" {
+		return errors.New("dlp.url is required")
+	}
+	if c.Username == "" || c.Password == "" {
+		return errors.New("dlp.username and dlp.password are required")
+	}
+	return nil
+}
+
+// checkpoint is the last incident published from a saved report or the
+// filter.
+type checkpoint struct {
+	IncidentID   int64     `json:"incident_id"`
+	CreationDate time.Time `json:"creation_date"`
+}
+
+// Collector lists the new incidents of the Enforce server and publishes them
+// with their details. The checkpoints are read from the registry once and
+// then kept in memory, the registry being updated as the events are
+// acknowledged.
+type Collector struct {
+	config      Config
+	client      *Client
+	store       *registry.Store
+	checkpoints map[string]checkpoint
+	build       client.EventBuilder
+	logger      *logp.Logger
+}
+
+// NewCollector creates a Collector keeping its checkpoints in store.
+func NewCollector(config Config, store *registry.Store, build client.EventBuilder) (*Collector, error) {
+	tlsConfig, err := client.LoadTLSConfig(config.SSL, config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Collector{
+		config:      config,
+		client:      NewClient(config.URL, config.Username, config.Password, tlsConfig),
+		store:       store,
+		checkpoints: map[string]checkpoint{},
+		build:       build,
+		logger:      logp.NewLogger("dlp"),
+	}, nil
+}
+
+// Collect lists the new incidents of every saved report, or of the filter
+// when there is none.
+func (c *Collector) Collect(out input.Outlet, now time.Time) error {
+	failed := 0
+	reports := c.config.SavedReportIDs
+	if len(reports) == 0 {
+		reports = []int{0}
+	}
+	for _, report := range reports {
+		if err := c.collectReport(out, report, now); err != nil {
+			c.logger.Errorf("Error collecting the incidents of saved report %d err=%s", report, err.Error())
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("the collection of %d saved reports failed", failed)
+	}
+	return nil
+}
+
+func checkpointKey(report int) string {
+	if report == 0 {
+		return "checkpoint/filter"
+	}
+	return "checkpoint/report/" + strconv.Itoa(report)
+}
+
+func (c *Collector) collectReport(out input.Outlet, report int, now time.Time) error {
+	key := checkpointKey(report)
+	cp, ok := c.checkpoints[key]
+	if !ok {
+		if _, err := c.store.Get(key, &cp); err != nil {
+			return err
+		}
+	}
+
+	filter := fieldFilter("localDateTime", "creationDate", "GT", now.Add(-c.config.StartDate).Format(dlpTimeFormat))
+	if cp.IncidentID > 0 {
+		filter = fieldFilter("long", "incidentId", "GT", cp.IncidentID)
+	}
+	if c.config.Filter != nil {
+		filter = andFilter(filter, c.config.Filter)
+	}
+
+	refs, err := c.client.ListIncidents(report, filter, c.config.BatchSize)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		incident, err := c.client.GetIncident(ref.ID)
+		if err != nil {
+			// The checkpoint stays before the incident, so it is fetched
+			// again on the next run.
+			return fmt.Errorf("error fetching incident %d: %v", ref.ID, err)
+		}
+		created, err := ref.Created()
+		if err != nil {
+			c.logger.Warnf("Incident %d has an invalid creation date %s", ref.ID, ref.CreationDate)
+			created = now
+		}
+		if !out.Publish(c.build.Rebuild(client.DLP_INCIDENT, newEvent(ref.ID, created, incident))) {
+			return nil
+		}
+
+		cp = checkpoint{IncidentID: ref.ID, CreationDate: created}
+		c.checkpoints[key] = cp
+		out.Checkpoint(input.Checkpoint{Key: key, Value: cp})
+	}
+	c.logger.Infof("Got %d new DLP incidents", len(refs))
+	return nil
+}
+
+func newEvent(id int64, created time.Time, incident Incident) beat.Event {
+	static := attributes(incident.StaticAttributes)
+	editable := attributes(incident.EditableAttributes)
+
+	details := common.MapStr{
+		"id":      id,
+		"created": created.Format(time.RFC3339Nano),
+	}
+	fields := common.MapStr{
+		"event_type":   EventType,
+		"time":         created.Format(time.RFC3339Nano),
+		"product_name": productName,
+		"dlp":          common.MapStr{"incident": details},
+	}
+
+	put := func(m common.MapStr, key, value string) {
+		if value != "" {
+			m.Put(key, value)
+		}
+	}
+	put(fields, "policy.name", static.str("policyName"))
+	put(fields, "policy.uid", static.str("policyId"))
+	put(fields, "policy.version", static.str("policyVersion"))
+	put(fields, "user_name", static.str("endpointUserName"))
+	put(fields, "device_name", static.str("endpointMachineName"))
+
+	if severity, ok := severities[editable.num("severityId")]; ok {
+		fields["severity_id"] = severity.id
+		details["severity"] = severity.name
+	}
+	put(details, "status", editable.str("incidentStatusName"))
+	put(details, "detection_server", static.str("detectionServerName"))
+	put(details, "message_type", static.str("messageType"))
+	put(details, "message_source", static.str("messageSource"))
+	put(details, "sender", static.str("sender"))
+	if recipients := static.list("recipients"); len(recipients) > 0 {
+		details["recipients"] = recipients
+	}
+	if matches := static.num("matchCount"); matches > 0 {
+		details["match_count"] = matches
+	}
+
+	var components []common.MapStr
+	for _, component := range incident.Components {
+		if component.MatchCount == 0 {
+			continue
+		}
+		components = append(components, common.MapStr{
+			"name":        component.Name,
+			"type":        component.Type,
+			"match_count": component.MatchCount,
+		})
+	}
+	if len(components) > 0 {
+		details["components"] = components
+	}
+
+	return beat.Event{Timestamp: created, Fields: fields}
+}
+
+// attributes reads the attribute values decoded from JSON.
+type attributes map[string]interface{}
+
+func (a attributes) str(key string) string {
+	switch v := a[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+func (a attributes) num(key string) int {
+	switch v := a[key].(type) {
+	case float64:
+		return int(v)
+	case string:
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	return 0
+}
+
+// list reads an attribute holding a list, or a comma separated string.
+func (a attributes) list(key string) []string {
+	var values []string
+	switch v := a[key].(type) {
+	case []interface{}:
+		for _, e := range v {
+			if s, ok := e.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
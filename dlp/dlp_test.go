@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package dlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	var requests []listRequest
+	server := httptest.NewServer(inputtest.BasicAuth("user", "pass", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case incidentsURL:
+			var request listRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			requests = append(requests, request)
+			if request.Filter["filterType"] == "long" {
+				fmt.Fprint(w, `{"totalCount":0,"incidents":[]}`)
+				return
+			}
+			fmt.Fprint(w, `{"totalCount":1,"incidents":[{"incidentId":42,"creationDate":"2020-04-08T11:59:00.123"}]}`)
+		case incidentsURL + "/42/staticAttributes":
+			fmt.Fprint(w, `{"infoMap":{"policyName":"PCI","policyId":7,"policyVersion":3,"detectionServerName":"Network Monitor",
+				"messageType":"SMTP","sender":"bob@corp.example","recipients":["x@example.com","y@example.com"],"matchCount":5}}`)
+		case incidentsURL + "/42/editableAttributes":
+			fmt.Fprint(w, `{"infoMap":{"severityId":1,"incidentStatusName":"New"}}`)
+		case incidentsURL + "/42/components":
+			fmt.Fprint(w, `[{"messageComponentId":1,"name":"cards.xlsx","messageComponentType":"ATTACHMENT","matchCount":5},
+				{"messageComponentId":2,"name":"Body","messageComponentType":"BODY","matchCount":0}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "dlp")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.URL = server.URL
+	config.Username = "user"
+	config.Password = "pass"
+	config.SavedReportIDs = []int{12}
+	c, err := NewCollector(config, store, inputtest.Build)
+	a.NoError(err)
+	p := inputtest.NewOutlet(store)
+
+	now := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	a.NoError(c.Collect(p, now))
+	if !a.Len(p.Events, 1) {
+		return
+	}
+	a.Equal(12, requests[0].SavedReportID)
+	a.Equal("creationDate", requests[0].Filter["operandOne"].(map[string]interface{})["name"])
+
+	event := p.Events[0]
+	a.Equal(time.Date(2020, 4, 8, 11, 59, 0, 123000000, time.UTC), event.Timestamp)
+	a.Equal("dlp_incident", event.Meta["pipeline"])
+	for key, expected := range map[string]interface{}{
+		"event_type":                    EventType,
+		"policy.name":                   "PCI",
+		"policy.uid":                    "7",
+		"severity_id":                   4,
+		"dlp.incident.id":               int64(42),
+		"dlp.incident.severity":         "HIGH",
+		"dlp.incident.status":           "New",
+		"dlp.incident.sender":           "bob@corp.example",
+		"dlp.incident.recipients":       []string{"x@example.com", "y@example.com"},
+		"dlp.incident.match_count":      5,
+		"dlp.incident.components":       []common.MapStr{{"name": "cards.xlsx", "type": "ATTACHMENT", "match_count": 5}},
+		"dlp.incident.message_type":     "SMTP",
+		"dlp.incident.detection_server": "Network Monitor",
+	} {
+		value, err := event.Fields.GetValue(key)
+		a.NoError(err, key)
+		a.Equal(expected, value, key)
+	}
+
+	// The next run continues after the last incident.
+	a.NoError(c.Collect(p, now.Add(time.Minute)))
+	a.Len(p.Events, 1)
+	a.Equal("incidentId", requests[1].Filter["operandOne"].(map[string]interface{})["name"])
+	a.Equal([]interface{}{float64(42)}, requests[1].Filter["operandTwoValues"])
+
+	var cp checkpoint
+	found, err := store.Get(checkpointKey(12), &cp)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(int64(42), cp.IncidentID)
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.URL = "https://dlp.example.com"
+	config.Username = "user"
+	config.Password = "pass"
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"dlp.period", func(c *Config) { c.Period = 0 }},
+		{"dlp.period", func(c *Config) { c.Period = -time.Minute }},
+		{"dlp.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"dlp.batch_size", func(c *Config) { c.BatchSize = MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dlp
+
+import (
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/input"
+)
+
+func init() {
+	if err := input.Register("dlp", newInput); err != nil {
+		panic(err)
+	}
+	if err := input.RegisterSettings("dlp", DefaultConfig); err != nil {
+		panic(err)
+	}
+}
+
+func newInput(cfg *common.Config, ctx input.Context) (input.Collector, error) {
+	config := DefaultConfig
+	config.Enabled = true
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	c, err := NewCollector(config, ctx.Store, ctx.Build)
+	if err != nil {
+		return nil, err
+	}
+	return input.NewPeriodic(config.Period, c, c.logger), nil
+}
@@ -0,0 +1,269 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package edr
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// MaxBatchSize is the largest page size requested from the EDR appliances.
+const MaxBatchSize = 1000
+
+const (
+	// EventType is the event_type of the EDR appliance events.
+	EventType = "EDR EVENT"
+	// IncidentEventType is the event_type of the EDR appliance incidents.
+	IncidentEventType = "EDR INCIDENT"
+
+	apiPath = "/atpapi"
+)
+
+// ApplianceConfig configures the access to an EDR appliance.
+type ApplianceConfig struct {
+	// Name is attached to the events, it defaults to the host of the URL.
+	Name         string        `config:"name"`
+	URL          string        `config:"url"`
+	ClientID     string        `config:"client_id"`
+	ClientSecret client.Secret `config:"client_secret"`
+}
+
+// Validate checks the appliance URL and credentials.
+func (c *ApplianceConfig) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid edr appliance url '%s'", c.URL)
+	}
+	if c.ClientID == "" || c.ClientSecret == "" {
+		return fmt.Errorf("client_id and client_secret are required for edr appliance %s", c.URL)
+	}
+	return nil
+}
+
+// name returns the name of the appliance.
+func (c ApplianceConfig) name() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return c.URL
+	}
+	return u.Hostname()
+}
+
+// Config configures the EDR appliances collector.
+type Config struct {
+	Enabled    bool              `config:"enabled"`
+	Appliances []ApplianceConfig `config:"appliances"`
+	// SSL applies to every appliance, e.g. to trust their CA.
+	SSL       *tlscommon.Config `config:"ssl"`
+	Period    time.Duration     `config:"period"`
+	BatchSize int               `config:"batch_size"`
+	// StartDate is how far back to look on the first run.
+	StartDate time.Duration `config:"start_date"`
+	Events    bool          `config:"events"`
+	Incidents bool          `config:"incidents"`
+	// Query filters the events, e.g. "type_id:(4096 OR 4098)".
+	Query      string              `config:"query"`
+	MaxRetries int                 `config:"max_retries"`
+	Backoff    input.BackoffConfig `config:"backoff"`
+}
+
+// DefaultConfig collects the events and incidents every 5 minutes.
+var DefaultConfig = Config{
+	Period:     5 * time.Minute,
+	BatchSize:  1000,
+	StartDate:  time.Hour,
+	Events:     true,
+	Incidents:  true,
+	MaxRetries: 3,
+	Backoff:    input.DefaultBackoff,
+}
+
+// Validate checks that the appliances are listed once.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("edr.period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > MaxBatchSize {
+		return fmt.Errorf("edr.batch_size must be between 1 and %d, got %d", MaxBatchSize, c.BatchSize)
+	}
+	if len(c.Appliances) == 0 {
+		return errors.New("edr.appliances must list at least one appliance")
+	}
+	names := map[string]bool{}
+	for _, a := range c.Appliances {
+		if names[a.name()] {
+			return fmt.Errorf("edr appliance %s is configured twice", a.name())
+		}
+		names[a.name()] = true
+	}
+	return nil
+}
+
+// Collector collects the events and incidents of one EDR appliance. The
+// checkpoints are kept per appliance, read from the registry once and then
+// kept in memory. The registry is updated as the events are acknowledged.
+type Collector struct {
+	config      Config
+	name        string
+	smClient    client.SymantecClient
+	store       *registry.Store
+	checkpoints map[string]time.Time
+	build       client.EventBuilder
+	logger      *logp.Logger
+}
+
+// NewCollector creates the Collector of an appliance.
+func NewCollector(config Config, appliance ApplianceConfig, store *registry.Store, build client.EventBuilder) (*Collector, error) {
+	tlsConfig, err := client.LoadTLSConfig(config.SSL, appliance.URL)
+	if err != nil {
+		return nil, err
+	}
+	apiURL := strings.TrimSuffix(appliance.URL, "/") + apiPath
+	smClient := client.NewSymantecClient(apiURL, "", "", appliance.ClientID, appliance.ClientSecret)
+	smClient.HTTPClient = client.NewHTTPClient(tlsConfig)
+	return &Collector{
+		config:      config,
+		name:        appliance.name(),
+		smClient:    smClient,
+		store:       store,
+		checkpoints: map[string]time.Time{},
+		build:       build,
+		logger:      logp.NewLogger("edr").With("appliance", appliance.name()),
+	}, nil
+}
+
+// Collect collects from the appliance up to end. The token is acquired once,
+// and renewed by the client when the appliance rejects it.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	if err := c.smClient.GetOauthToken(); err != nil {
+		return fmt.Errorf("error getting the access token of edr appliance %s, check the credentials: %v", c.name, err)
+	}
+
+	failed := 0
+	if c.config.Events {
+		if err := c.collectPath(out, client.EDREventsURL, client.EDR_EVENT, c.config.Query, end); err != nil {
+			c.logger.Errorf("Error collecting EDR events err=%s", err.Error())
+			failed++
+		}
+	}
+	if c.config.Incidents {
+		if err := c.collectPath(out, client.EDRIncidentsURL, client.EDR_INCIDENT, "", end); err != nil {
+			c.logger.Errorf("Error collecting EDR incidents err=%s", err.Error())
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d EDR collections failed", failed)
+	}
+	return nil
+}
+
+// collectPath queries an endpoint from its checkpoint to end and publishes
+// the results page by page, retrying a failing page with a backoff. The
+// checkpoint moves to end once every page is published.
+func (c *Collector) collectPath(out input.Outlet, path string, t client.EventType, query string, end time.Time) error {
+	key := "checkpoint/" + c.name + path
+	start, ok := c.checkpoints[key]
+	if !ok {
+		start = end.Add(-c.config.StartDate)
+		if _, err := c.store.Get(key, &start); err != nil {
+			return err
+		}
+	}
+
+	var next string
+	total := 0
+	for {
+		results, pageNext, err := c.queryPage(out, path, start, end, query, next)
+		if err != nil || out.Stopped() {
+			return err
+		}
+		for _, fields := range results {
+			fields["event_type"] = t.String()
+			fields.Put("edr.appliance", c.name)
+			if !out.Publish(c.build.Rebuild(t, beat.Event{
+				Timestamp: eventTime(fields),
+				Fields:    fields,
+			})) {
+				return nil
+			}
+		}
+		total += len(results)
+		if pageNext == "" {
+			break
+		}
+		next = pageNext
+	}
+
+	c.logger.Infof("Got %d results from %s", total, path)
+	c.checkpoints[key] = end
+	out.Checkpoint(input.Checkpoint{Key: key, Value: end})
+	return nil
+}
+
+// queryPage requests a page of results, retrying with a backoff.
+func (c *Collector) queryPage(out input.Outlet, path string, start, end time.Time, query, next string) ([]common.MapStr, string, error) {
+	b := c.config.Backoff.New(out.Done())
+	for attempt := 0; ; attempt++ {
+		results, pageNext, err := c.smClient.QueryEDR(path, start, end, query, c.config.BatchSize, next)
+		if err == nil {
+			return results, pageNext, nil
+		}
+		if attempt >= c.config.MaxRetries {
+			return nil, "", err
+		}
+		c.logger.Warnf("Error querying %s, retrying err=%s", path, err.Error())
+		if !b.Wait() {
+			return nil, "", nil
+		}
+	}
+}
+
+// eventTime returns the time of an EDR event or incident, falling back to the
+// current time.
+func eventTime(fields common.MapStr) time.Time {
+	if ts, ok := fields["device_time"].(float64); ok {
+		return time.Unix(0, int64(ts)*int64(time.Millisecond)).UTC()
+	}
+	for _, key := range []string{"time", "log_time", "created"} {
+		if ts, ok := fields[key].(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				return t
+			}
+		}
+	}
+	return time.Now()
+}
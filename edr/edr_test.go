@@ -0,0 +1,163 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package edr
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	var queries []map[string]interface{}
+	failures := 1
+	tokens := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/atpapi/oauth2/tokens":
+			if user, _, _ := r.BasicAuth(); user != "id" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			tokens++
+			fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+		case "/atpapi/v2/events":
+			var query map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&query)
+			// The second page fails once, and is requested again.
+			if query["next"] != nil && failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			queries = append(queries, query)
+			if query["next"] == nil {
+				fmt.Fprint(w, `{"next":"n1","total":2,"result":[{"type_id":4096,"device_time":1586346000000}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"total":2,"result":[{"type_id":4098,"device_time":1586346060000}]}`)
+		case "/atpapi/v2/incidents":
+			fmt.Fprint(w, `{"total":1,"result":[{"atp_incident_id":"1","time":"2020-04-08T11:30:00.000Z"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "edr")
+	defer cleanup()
+
+	// The appliance is trusted through the ssl settings.
+	dir, err := ioutil.TempDir("", "edr")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	ca := filepath.Join(dir, "ca.pem")
+	a.NoError(ioutil.WriteFile(ca, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	config := DefaultConfig
+	config.SSL = &tlscommon.Config{CAs: []string{ca}}
+	config.Query = "type_id:(4096 OR 4098)"
+	config.Backoff.Init = time.Millisecond
+	config.Backoff.Max = time.Millisecond
+	appliance := ApplianceConfig{URL: server.URL, ClientID: "id", ClientSecret: "secret"}
+	a.NoError(appliance.Validate())
+	c, err := NewCollector(config, appliance, store, inputtest.Build)
+	a.NoError(err)
+	p := inputtest.NewOutlet(store)
+
+	end := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	a.NoError(c.Collect(p, end))
+	if !a.Len(p.Events, 3) {
+		return
+	}
+	a.Equal("type_id:(4096 OR 4098)", queries[0]["query"])
+	a.Equal("2020-04-08T11:00:00Z", queries[0]["start_time"])
+	a.Equal("n1", queries[1]["next"])
+	// The token is acquired once for every page.
+	a.Equal(1, tokens)
+
+	a.Equal(EventType, p.Events[0].Fields["event_type"])
+	a.Equal("edr_event", p.Events[0].Meta["pipeline"])
+	a.Equal(time.Unix(1586346000, 0).UTC(), p.Events[0].Timestamp)
+	name, _ := p.Events[0].Fields.GetValue("edr.appliance")
+	a.Equal("127.0.0.1", name)
+	a.Equal(IncidentEventType, p.Events[2].Fields["event_type"])
+	a.Equal("edr_incident", p.Events[2].Meta["pipeline"])
+	a.Equal(time.Date(2020, 4, 8, 11, 30, 0, 0, time.UTC), p.Events[2].Timestamp)
+
+	var checkpoint time.Time
+	found, err := store.Get("checkpoint/127.0.0.1/v2/events", &checkpoint)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(end, checkpoint)
+}
+
+func TestValidate(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	assert.Error(t, config.Validate())
+
+	appliance := ApplianceConfig{URL: "https://edr01.example.com", ClientID: "id", ClientSecret: "secret"}
+	config.Appliances = []ApplianceConfig{appliance, appliance}
+	assert.Error(t, config.Validate())
+
+	appliance.Name = "edr02"
+	config.Appliances[1] = appliance
+	assert.NoError(t, config.Validate())
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.Appliances = []ApplianceConfig{{URL: "https://edr01.example.com", ClientID: "id", ClientSecret: "secret"}}
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"edr.period", func(c *Config) { c.Period = 0 }},
+		{"edr.period", func(c *Config) { c.Period = -time.Minute }},
+		{"edr.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"edr.batch_size", func(c *Config) { c.BatchSize = MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
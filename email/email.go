@@ -0,0 +1,231 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package email
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+const cursorPrefix = "cursor/"
+
+// feeds maps the feed types to their data feed API path.
+var feeds = map[string]string{
+	"malware":        "malware",
+	"url_protection": "clicktime",
+	"av_as":          "avas",
+	"dlp":            "dlp",
+}
+
+// Config configures the Email Security.cloud data feeds collector.
+type Config struct {
+	Enabled  bool          `config:"enabled"`
+	URL      string        `config:"url"`
+	Username string        `config:"username"`
+	Password client.Secret `config:"password"`
+	Feeds    []string      `config:"feeds"`
+	Period   time.Duration `config:"period"`
+	// StartDate is how far back to read a feed without cursor.
+	StartDate time.Duration `config:"start_date"`
+	// MaxRetries is the number of times a failed request is retried before
+	// the feed is given up until the next period.
+	MaxRetries int                 `config:"max_retries"`
+	Backoff    input.BackoffConfig `config:"backoff"`
+}
+
+// DefaultConfig polls every feed each minute.
+var DefaultConfig = Config{
+	URL:        "https://datafeeds.emailsecurity.symantec.com",
+	Feeds:      []string{"malware", "url_protection", "av_as", "dlp"},
+	Period:     time.Minute,
+	StartDate:  time.Hour,
+	MaxRetries: 3,
+	Backoff:    input.DefaultBackoff,
+}
+
+// Validate checks the credentials and the feed types.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("email_security.period must be positive, got %v", c.Period)
+	}
+	if c.Username == "" || c.Password == "" {
+		return errors.New("email_security.username and email_security.password are required")
+	}
+	if len(c.Feeds) == 0 {
+		return errors.New("email_security.feeds must list at least one feed")
+	}
+	for _, feed := range c.Feeds {
+		if _, ok := feeds[feed]; !ok {
+			return fmt.Errorf("unknown email_security feed '%s', expected malware, url_protection, av_as or dlp", feed)
+		}
+	}
+	return nil
+}
+
+// Collector polls the configured data feeds. Every feed has its own cursor in
+// the registry, read once and then kept in memory. The registry is updated as
+// the events are acknowledged.
+type Collector struct {
+	config     Config
+	store      *registry.Store
+	cursors    map[string]string
+	httpClient *http.Client
+	build      client.EventBuilder
+	logger     *logp.Logger
+}
+
+// NewCollector creates a Collector keeping its cursors in store.
+func NewCollector(config Config, store *registry.Store, build client.EventBuilder) *Collector {
+	return &Collector{
+		config:     config,
+		store:      store,
+		cursors:    map[string]string{},
+		httpClient: &http.Client{Timeout: time.Minute},
+		build:      build,
+		logger:     logp.NewLogger("email_security"),
+	}
+}
+
+// Collect reads every feed up to end.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	failed := 0
+	for _, feed := range c.config.Feeds {
+		if err := c.collectFeed(out, feed, end); err != nil {
+			c.logger.Errorf("Error reading the %s feed err=%s", feed, err.Error())
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("reading %d feeds failed", failed)
+	}
+	return nil
+}
+
+// collectFeed reads the feed until it returns no more events, or an event
+// after end. The cursor is not moved past the page holding that event, which
+// is read again on the next run.
+func (c *Collector) collectFeed(out input.Outlet, feed string, end time.Time) error {
+	cursor, ok := c.cursors[feed]
+	if !ok {
+		if _, err := c.store.Get(cursorPrefix+feed, &cursor); err != nil {
+			return err
+		}
+	}
+
+	for {
+		var page feedPage
+		b := c.config.Backoff.New(out.Done())
+		for attempt := 0; ; attempt++ {
+			var err error
+			page, err = c.fetch(feed, cursor, end)
+			if err == nil {
+				break
+			}
+			if attempt >= c.config.MaxRetries {
+				return err
+			}
+			c.logger.Warnf("Error reading the %s feed, retrying err=%s", feed, err.Error())
+			if !b.Wait() {
+				return nil
+			}
+		}
+
+		for _, raw := range page.events {
+			event, err := newEvent(feed, raw)
+			if err != nil {
+				c.logger.Errorf("dropping %s feed event err=%s", feed, err.Error())
+				continue
+			}
+			if event.Timestamp.After(end) {
+				return nil
+			}
+			if !out.Publish(c.build.Rebuild(client.EMAIL_SECURITY, event)) {
+				return nil
+			}
+		}
+
+		if page.cursor == "" || page.cursor == cursor {
+			return nil
+		}
+		cursor = page.cursor
+		c.cursors[feed] = cursor
+		out.Checkpoint(input.Checkpoint{Key: cursorPrefix + feed, Value: cursor})
+		if len(page.events) == 0 {
+			return nil
+		}
+	}
+}
+
+type feedPage struct {
+	cursor string
+	events []json.RawMessage
+}
+
+// fetch requests the events of the feed following the cursor. Without a
+// cursor, the feed is read from the start date before end.
+func (c *Collector) fetch(feed, cursor string, end time.Time) (feedPage, error) {
+	params := url.Values{}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	} else {
+		params.Set("startFrom", end.Add(-c.config.StartDate).Format(time.RFC3339))
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.config.URL+"/"+feeds[feed]+"?"+params.Encode(), nil)
+	if err != nil {
+		return feedPage{}, err
+	}
+	req.SetBasicAuth(c.config.Username, string(c.config.Password))
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return feedPage{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return feedPage{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return feedPage{}, fmt.Errorf("GET %s returned %s", feeds[feed], resp.Status)
+	}
+
+	page := feedPage{cursor: resp.Header.Get("X-Cursor")}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &page.events); err != nil {
+			return feedPage{}, fmt.Errorf("error decoding the %s feed: %v", feed, err)
+		}
+	}
+	return page, nil
+}
@@ -0,0 +1,195 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package email
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+const malwareEvent = `{
+  "timestamp": "2020-04-08T11:59:00Z",
+  "emailInfo": {
+    "xMsgRef": "1586346000-12",
+    "messageId": "<abc@example.com>",
+    "subject": "Invoice",
+    "envFrom": "bounce@example.com",
+    "envTo": ["alice@corp.example"],
+    "headerFrom": "billing@example.com",
+    "senderIp": "198.51.100.7",
+    "isOutbound": false,
+    "attachments": [{"fileNameOrigin": "invoice.exe", "fileSize": 1024, "sha256": "aa11"}]
+  },
+  "incidents": [{"securityService": "Anti-Malware", "action": "Deleted", "threatName": "Trojan.Gen"}]
+}`
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	var queries []string
+	failures := 1
+	server := httptest.NewServer(inputtest.BasicAuth("user", "pass", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/malware" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("X-Cursor", "c1")
+			fmt.Fprintf(w, "[%s]", malwareEvent)
+			return
+		}
+		w.Header().Set("X-Cursor", "c1")
+		fmt.Fprint(w, "[]")
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "email")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.URL = server.URL
+	config.Username = "user"
+	config.Password = "pass"
+	config.Backoff.Init = time.Millisecond
+	config.Backoff.Max = time.Millisecond
+	c := NewCollector(config, store, inputtest.Build)
+	p := inputtest.NewOutlet(store)
+
+	now := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	a.NoError(c.collectFeed(p, "malware", now))
+	a.Equal([]string{"startFrom=2020-04-08T11%3A00%3A00Z", "cursor=c1"}, queries)
+	if !a.Len(p.Events, 1) {
+		return
+	}
+
+	event := p.Events[0]
+	a.Equal(time.Date(2020, 4, 8, 11, 59, 0, 0, time.UTC), event.Timestamp)
+	a.Equal("email_security", event.Meta["pipeline"])
+	for key, expected := range map[string]interface{}{
+		"event_type":               "EMAIL SECURITY",
+		"email.message_id":         "abc@example.com",
+		"email.from.address":       []string{"billing@example.com"},
+		"email.sender.address":     "bounce@example.com",
+		"email.to.address":         []string{"alice@corp.example"},
+		"email.direction":          "inbound",
+		"source.ip":                "198.51.100.7",
+		"threat.name":              "Trojan.Gen",
+		"email_security.feed":      "malware",
+		"email_security.incidents": []common.MapStr{{"service": "Anti-Malware", "action": "Deleted"}},
+		"email.attachments":        []common.MapStr{{"file": common.MapStr{"name": "invoice.exe", "size": int64(1024), "hash": common.MapStr{"sha256": "aa11"}}}},
+	} {
+		value, err := event.Fields.GetValue(key)
+		a.NoError(err, key)
+		a.Equal(expected, value, key)
+	}
+
+	var cursor string
+	found, err := store.Get(cursorPrefix+"malware", &cursor)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("c1", cursor)
+}
+
+func TestCollectStopsAtEnd(t *testing.T) {
+	a := assert.New(t)
+
+	later := strings.Replace(malwareEvent, "2020-04-08T11:59:00", "2020-04-08T12:01:00", 1)
+	var queries []string
+	server := httptest.NewServer(inputtest.BasicAuth("user", "pass", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("X-Cursor", "c1")
+		fmt.Fprintf(w, "[%s,%s]", malwareEvent, later)
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "email")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.URL = server.URL
+	config.Username = "user"
+	config.Password = "pass"
+	c := NewCollector(config, store, inputtest.Build)
+	p := inputtest.NewOutlet(store)
+
+	// The event after end stops the feed, which is read from the same cursor
+	// on the next run.
+	end := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	a.NoError(c.collectFeed(p, "malware", end))
+	a.Len(queries, 1)
+	if a.Len(p.Events, 1) {
+		a.Equal(time.Date(2020, 4, 8, 11, 59, 0, 0, time.UTC), p.Events[0].Timestamp)
+	}
+	var cursor string
+	found, err := store.Get(cursorPrefix+"malware", &cursor)
+	a.NoError(err)
+	a.False(found)
+}
+
+func TestValidate(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.Username = "user"
+	config.Password = "pass"
+	assert.NoError(t, config.Validate())
+
+	config.Feeds = []string{"malware", "spam"}
+	assert.Error(t, config.Validate())
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.Username = "user"
+	config.Password = "pass"
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"email_security.period", func(c *Config) { c.Period = 0 }},
+		{"email_security.period", func(c *Config) { c.Period = -time.Minute }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
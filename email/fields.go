@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package email
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	// EventType is the event_type of the Email Security.cloud events.
+	EventType = "EMAIL SECURITY"
+
+	productName = "Symantec Email Security.cloud"
+)
+
+// feedEvent is an event of the data feeds. Only the parts mapped to the
+// email fields are decoded.
+type feedEvent struct {
+	Timestamp string    `json:"timestamp"`
+	EmailInfo emailInfo `json:"emailInfo"`
+	Incidents []struct {
+		SecurityService string `json:"securityService"`
+		Action          string `json:"action"`
+		Reason          string `json:"reason"`
+		Verdict         string `json:"verdict"`
+		DetectionMethod string `json:"detectionMethod"`
+		ThreatName      string `json:"threatName"`
+	} `json:"incidents"`
+}
+
+type emailInfo struct {
+	XMsgRef          string       `json:"xMsgRef"`
+	MessageID        string       `json:"messageId"`
+	Subject          string       `json:"subject"`
+	EnvFrom          string       `json:"envFrom"`
+	EnvTo            []string     `json:"envTo"`
+	HeaderFrom       string       `json:"headerFrom"`
+	HeaderTo         []string     `json:"headerTo"`
+	HeaderReplyTo    string       `json:"headerReplyTo"`
+	SenderIP         string       `json:"senderIp"`
+	SenderMailserver string       `json:"senderMailserver"`
+	MessageSize      int64        `json:"messageSize"`
+	IsOutbound       bool         `json:"isOutbound"`
+	Attachments      []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	FileName string `json:"fileNameOrigin"`
+	FileSize int64  `json:"fileSize"`
+	FileType string `json:"fileType"`
+	SHA256   string `json:"sha256"`
+	MD5      string `json:"md5"`
+}
+
+// newEvent maps a feed event to the ECS email fields. The outcome of the
+// scanning services is kept under email_security.
+func newEvent(feed string, raw json.RawMessage) (beat.Event, error) {
+	var e feedEvent
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return beat.Event{}, err
+	}
+	info := e.EmailInfo
+
+	fields := common.MapStr{
+		"event_type":   EventType,
+		"product_name": productName,
+		"email_security": common.MapStr{
+			"feed": feed,
+		},
+	}
+	ts, err := time.Parse(time.RFC3339Nano, e.Timestamp)
+	if err != nil {
+		ts = time.Now().UTC()
+	}
+	fields["time"] = ts.Format(time.RFC3339Nano)
+
+	email := common.MapStr{}
+	put := func(m common.MapStr, key string, value string) {
+		if value != "" {
+			m.Put(key, value)
+		}
+	}
+	put(email, "local_id", info.XMsgRef)
+	put(email, "message_id", strings.Trim(info.MessageID, "<>"))
+	put(email, "subject", info.Subject)
+	put(email, "sender.address", info.EnvFrom)
+	put(email, "reply_to.address", info.HeaderReplyTo)
+	if from := firstNonEmpty(info.HeaderFrom, info.EnvFrom); from != "" {
+		email.Put("from.address", []string{from})
+	}
+	if to := info.HeaderTo; len(to) > 0 {
+		email.Put("to.address", to)
+	} else if len(info.EnvTo) > 0 {
+		email.Put("to.address", info.EnvTo)
+	}
+	email["direction"] = "inbound"
+	if info.IsOutbound {
+		email["direction"] = "outbound"
+	}
+	if info.MessageSize > 0 {
+		email["size"] = info.MessageSize
+	}
+
+	var attachments []common.MapStr
+	for _, a := range info.Attachments {
+		file := common.MapStr{}
+		put(file, "name", a.FileName)
+		put(file, "mime_type", a.FileType)
+		put(file, "hash.sha256", a.SHA256)
+		put(file, "hash.md5", a.MD5)
+		if a.FileSize > 0 {
+			file["size"] = a.FileSize
+		}
+		attachments = append(attachments, common.MapStr{"file": file})
+	}
+	if len(attachments) > 0 {
+		email["attachments"] = attachments
+	}
+	fields["email"] = email
+
+	put(fields, "source.ip", info.SenderIP)
+	put(fields, "source.domain", info.SenderMailserver)
+
+	var incidents []common.MapStr
+	for _, i := range e.Incidents {
+		incident := common.MapStr{}
+		put(incident, "service", i.SecurityService)
+		put(incident, "action", i.Action)
+		put(incident, "reason", i.Reason)
+		put(incident, "verdict", i.Verdict)
+		put(incident, "detection_method", i.DetectionMethod)
+		incidents = append(incidents, incident)
+		if i.ThreatName != "" {
+			fields.Put("threat.name", i.ThreatName)
+		}
+	}
+	if len(incidents) > 0 {
+		fields.Put("email_security.incidents", incidents)
+	}
+	return beat.Event{Timestamp: ts, Fields: fields}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,344 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package icdx
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// MaxBatchSize is the largest page size requested from an ICDX search.
+const MaxBatchSize = 10000
+
+const (
+	searchURL = "/api/v1/events/search"
+
+	checkpointPrefix = "checkpoint/"
+	// boundaryPrefix keeps the ids of the events published at the time of
+	// the checkpoint.
+	boundaryPrefix = "boundary/"
+
+	icdxTimeFormat = "2006-01-02T15:04:05.000Z"
+)
+
+// QueryConfig is a search run on every period.
+type QueryConfig struct {
+	// Name identifies the checkpoint of the query and is published in
+	// icdx.query.
+	Name string `config:"name"`
+	// Where is the ICDx search condition, e.g. "type_id = 8031".
+	Where string `config:"where"`
+}
+
+// Config configures the ICDx event search collector.
+type Config struct {
+	Enabled   bool              `config:"enabled"`
+	URL       string            `config:"url"`
+	Username  string            `config:"username"`
+	Password  client.Secret     `config:"password"`
+	SSL       *tlscommon.Config `config:"ssl"`
+	Queries   []QueryConfig     `config:"queries"`
+	Period    time.Duration     `config:"period"`
+	BatchSize int               `config:"batch_size"`
+	// StartDate is how far back to search on the first run.
+	StartDate time.Duration `config:"start_date"`
+}
+
+// DefaultConfig searches every 5 minutes.
+var DefaultConfig = Config{
+	Period:    5 * time.Minute,
+	BatchSize: 1000,
+	StartDate: time.Hour,
+}
+
+// Validate checks the server, the credentials and the queries.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("icdx.period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > MaxBatchSize {
+		return fmt.Errorf("icdx.batch_size must be between 1 and %d, got %d", MaxBatchSize, c.BatchSize)
+	}
+	if c.URL == "" {
+		return errors.New("icdx.url is required")
+	}
+	if c.Username == "" || c.Password == "" {
+		return errors.New("icdx.username and icdx.password are required")
+	}
+	if len(c.Queries) == 0 {
+		return errors.New("icdx.queries must list at least one query")
+	}
+	names := map[string]bool{}
+	for _, q := range c.Queries {
+		if q.Name == "" {
+			return errors.New("every icdx query needs a name")
+		}
+		if names[q.Name] {
+			return fmt.Errorf("icdx query %s is configured twice", q.Name)
+		}
+		names[q.Name] = true
+	}
+	return nil
+}
+
+// Collector runs the configured searches and publishes the events found
+// since the log_time checkpoint of each query. The checkpoints are read from
+// the registry once and then kept in memory, the registry being updated as
+// the events are acknowledged.
+type Collector struct {
+	config           Config
+	keySanitization  client.KeySanitization
+	preserveOriginal bool
+	store            *registry.Store
+	positions        map[string]position
+	build            client.EventBuilder
+	httpClient       *http.Client
+	logger           *logp.Logger
+}
+
+// NewCollector creates a Collector keeping its checkpoints in store. The
+// events are decoded like the SES events, with the given key sanitization
+// and preservation of the original event.
+func NewCollector(config Config, keySanitization client.KeySanitization, preserveOriginal bool, store *registry.Store, build client.EventBuilder) (*Collector, error) {
+	tlsConfig, err := client.LoadTLSConfig(config.SSL, config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Collector{
+		config:           config,
+		keySanitization:  keySanitization,
+		preserveOriginal: preserveOriginal,
+		store:            store,
+		positions:        map[string]position{},
+		build:            build,
+		httpClient:       client.NewHTTPClient(tlsConfig),
+		logger:           logp.NewLogger("icdx"),
+	}, nil
+}
+
+// Collect runs the queries up to end.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	failed := 0
+	for _, q := range c.config.Queries {
+		if err := c.search(out, q, end); err != nil {
+			c.logger.Errorf("Error running ICDx query %s err=%s", q.Name, err.Error())
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d ICDx queries failed", failed)
+	}
+	return nil
+}
+
+type searchRequest struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Where string `json:"where,omitempty"`
+	Limit int    `json:"limit"`
+	Next  string `json:"next,omitempty"`
+}
+
+type searchResponse struct {
+	Next   string            `json:"next"`
+	Result []json.RawMessage `json:"result"`
+}
+
+// position is where a query stopped: the latest log_time published and the
+// ids of the events published with it. ICDx times have a millisecond
+// precision, so the next search starts at that time and skips these events.
+type position struct {
+	logTime time.Time
+	ids     map[string]bool
+}
+
+func (p *position) add(logTime time.Time, id string) {
+	if logTime.After(p.logTime) {
+		p.logTime = logTime
+		p.ids = map[string]bool{}
+	}
+	p.ids[id] = true
+}
+
+func (p position) checkpoints(name string) []input.Checkpoint {
+	ids := make([]string, 0, len(p.ids))
+	for id := range p.ids {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return []input.Checkpoint{
+		{Key: checkpointPrefix + name, Value: p.logTime},
+		{Key: boundaryPrefix + name, Value: ids},
+	}
+}
+
+// position returns the position of the query, read from the registry the
+// first time.
+func (c *Collector) position(name string, end time.Time) (position, error) {
+	if p, ok := c.positions[name]; ok {
+		return p, nil
+	}
+
+	p := position{logTime: end.Add(-c.config.StartDate), ids: map[string]bool{}}
+	if _, err := c.store.Get(checkpointPrefix+name, &p.logTime); err != nil {
+		return p, err
+	}
+	var ids []string
+	if _, err := c.store.Get(boundaryPrefix+name, &ids); err != nil {
+		return p, err
+	}
+	for _, id := range ids {
+		p.ids[id] = true
+	}
+	return p, nil
+}
+
+// search pages through the events logged from the position of the query and
+// moves it to the latest log_time once the events are acknowledged.
+func (c *Collector) search(out input.Outlet, q QueryConfig, end time.Time) error {
+	start, err := c.position(q.Name, end)
+	if err != nil {
+		return err
+	}
+
+	request := searchRequest{
+		Start: start.logTime.Format(icdxTimeFormat),
+		End:   end.Format(icdxTimeFormat),
+		Where: q.Where,
+		Limit: c.config.BatchSize,
+	}
+	latest := position{logTime: start.logTime, ids: map[string]bool{}}
+	for id := range start.ids {
+		latest.ids[id] = true
+	}
+	events := 0
+	for {
+		var page searchResponse
+		if err := c.post(request, &page); err != nil {
+			return err
+		}
+
+		for _, raw := range page.Result {
+			fields, err := client.DecodeEvent(raw, c.keySanitization, c.preserveOriginal)
+			if err != nil {
+				c.logger.Errorf("dropping ICDx event err=%s", err.Error())
+				continue
+			}
+			id := eventID(fields, raw)
+			logTime, ok := parseLogTime(fields)
+			if ok && (logTime.Before(start.logTime) || logTime.Equal(start.logTime) && start.ids[id]) {
+				continue
+			}
+
+			t := client.EventTypeOf(fields)
+			fields["event_type"] = t.String()
+			fields.Put("icdx.query", q.Name)
+			if !out.Publish(c.build(t, fields)) {
+				return nil
+			}
+			events++
+			if ok {
+				latest.add(logTime, id)
+			}
+		}
+
+		if page.Next == "" || len(page.Result) == 0 {
+			break
+		}
+		if out.Stopped() {
+			return nil
+		}
+		request.Next = page.Next
+	}
+	c.logger.Infof("ICDx query %s returned %d new events", q.Name, events)
+	c.positions[q.Name] = latest
+	out.Checkpoint(latest.checkpoints(q.Name)...)
+	return nil
+}
+
+// eventID returns the uuid of an event, or a hash of the event when it has
+// none.
+func eventID(fields common.MapStr, raw []byte) string {
+	if id, ok := fields["uuid"].(string); ok && id != "" {
+		return id
+	}
+	sum := sha1.Sum(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// parseLogTime returns the time ICDx received the event.
+func parseLogTime(fields common.MapStr) (time.Time, bool) {
+	s, ok := fields["log_time"].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	return t, err == nil
+}
+
+func (c *Collector) post(request searchRequest, v interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.config.URL+searchURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.config.Username, string(c.config.Password))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	response, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("POST %s returned %s", searchURL, resp.Status)
+	}
+	if err := json.Unmarshal(response, v); err != nil {
+		return fmt.Errorf("error decoding ICDx search response: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package icdx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	var requests []searchRequest
+	server := httptest.NewServer(inputtest.BasicAuth("user", "password", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != searchURL {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var request searchRequest
+		json.NewDecoder(r.Body).Decode(&request)
+		requests = append(requests, request)
+		if request.Next == "" {
+			fmt.Fprint(w, `{"next":"n1","result":[
+				{"uuid":"e1","type_id":8031,"feature_name":"Malware Protection","log_time":"2020-04-08T11:10:00.000Z","device_name":"host-1"},
+				{"uuid":"e0","type_id":8031,"feature_name":"Malware Protection","log_time":"2020-04-08T10:59:00.000Z"},
+				{"uuid":"e2","type_id":8031,"feature_name":"Malware Protection","log_time":"2020-04-08T11:00:00.000Z"},
+				{"uuid":"e3","type_id":8031,"feature_name":"Malware Protection","log_time":"2020-04-08T11:00:00.000Z"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"result":[
+			{"uuid":"e4","type_id":8001,"feature_name":"Firewall","log_time":"2020-04-08T11:20:00.000Z"},
+			{"uuid":"e5","type_id":8001,"feature_name":"Firewall","log_time":"2020-04-08T11:20:00.000Z"}]}`)
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "icdx")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.Enabled = true
+	config.URL = server.URL
+	config.Username = "user"
+	config.Password = "password"
+	config.Queries = []QueryConfig{{Name: "detections", Where: "type_id = 8031"}}
+	a.NoError(config.Validate())
+
+	var types []client.EventType
+	build := func(t client.EventType, fields common.MapStr) beat.Event {
+		types = append(types, t)
+		return beat.Event{Fields: fields}
+	}
+	c, err := NewCollector(config, client.KeepKeys, false, store, build)
+	a.NoError(err)
+	p := inputtest.NewOutlet(store)
+
+	// The checkpoint of a previous run skips the events already published,
+	// the ones logged in the same millisecond included.
+	a.NoError(store.Set("checkpoint/detections", time.Date(2020, 4, 8, 11, 0, 0, 0, time.UTC)))
+	a.NoError(store.Set("boundary/detections", []string{"e2"}))
+	end := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	a.NoError(c.Collect(p, end))
+	if !a.Len(p.Events, 4) || !a.Len(requests, 2) {
+		return
+	}
+	a.Equal("2020-04-08T11:00:00.000Z", requests[0].Start)
+	a.Equal("2020-04-08T12:00:00.000Z", requests[0].End)
+	a.Equal("type_id = 8031", requests[0].Where)
+	a.Equal("n1", requests[1].Next)
+
+	a.Equal([]client.EventType{client.MALWARE_PROTECTION, client.MALWARE_PROTECTION, client.FIREWALL, client.FIREWALL}, types)
+	a.Equal(client.MALWARE_PROTECTION.String(), p.Events[0].Fields["event_type"])
+	a.Equal("host-1", p.Events[0].Fields["device_name"])
+	a.Equal("e3", p.Events[1].Fields["uuid"])
+	query, _ := p.Events[2].Fields.GetValue("icdx.query")
+	a.Equal("detections", query)
+
+	var checkpoint time.Time
+	found, err := store.Get("checkpoint/detections", &checkpoint)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(time.Date(2020, 4, 8, 11, 20, 0, 0, time.UTC), checkpoint)
+	var boundary []string
+	_, err = store.Get("boundary/detections", &boundary)
+	a.NoError(err)
+	a.Equal([]string{"e4", "e5"}, boundary)
+
+	// The next search starts at the checkpoint and skips its events.
+	p = inputtest.NewOutlet(store)
+	a.NoError(c.Collect(p, end.Add(time.Hour)))
+	a.Equal("2020-04-08T11:20:00.000Z", requests[2].Start)
+	a.Empty(p.Events)
+}
+
+func TestValidate(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.URL = "https://icdx.example.com"
+	config.Username = "user"
+	config.Password = "password"
+	assert.Error(t, config.Validate())
+
+	query := QueryConfig{Name: "detections", Where: "type_id = 8031"}
+	config.Queries = []QueryConfig{query, query}
+	assert.Error(t, config.Validate())
+
+	config.Queries = config.Queries[:1]
+	assert.NoError(t, config.Validate())
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.URL = "https://icdx.example.com"
+	config.Username = "user"
+	config.Password = "password"
+	config.Queries = []QueryConfig{{Name: "detections", Where: "type_id = 8031"}}
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"icdx.period", func(c *Config) { c.Period = 0 }},
+		{"icdx.period", func(c *Config) { c.Period = -time.Minute }},
+		{"icdx.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"icdx.batch_size", func(c *Config) { c.BatchSize = MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
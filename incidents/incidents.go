@@ -0,0 +1,240 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package incidents
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+const (
+	// EventType is the event_type of the published incident documents.
+	EventType = "INCIDENT"
+
+	checkpointKey = "checkpoint"
+	statePrefix   = "incident/"
+)
+
+// Config configures the incidents collector.
+type Config struct {
+	Enabled   bool          `config:"enabled"`
+	Period    time.Duration `config:"period"`
+	BatchSize int           `config:"batch_size"`
+	StartDate time.Duration `config:"start_date"`
+	// StateTTL is how long the state of an incident that is not modified
+	// anymore is kept in the registry.
+	StateTTL time.Duration `config:"state_ttl"`
+}
+
+// DefaultConfig polls the incidents every 5 minutes.
+var DefaultConfig = Config{
+	Period:    5 * time.Minute,
+	BatchSize: 100,
+	StartDate: 24 * time.Hour,
+	StateTTL:  30 * 24 * time.Hour,
+}
+
+// Validate checks the period, the page size and the durations.
+func (c *Config) Validate() error {
+	if c.Period <= 0 {
+		return fmt.Errorf("incidents.period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > client.MaxBatchSize {
+		return fmt.Errorf("incidents.batch_size must be between 1 and %d, got %d", client.MaxBatchSize, c.BatchSize)
+	}
+	if c.StartDate < 0 {
+		return fmt.Errorf("incidents.start_date cannot be negative, got %v", c.StartDate)
+	}
+	if c.StateTTL <= 0 {
+		return fmt.Errorf("incidents.state_ttl must be positive, got %v", c.StateTTL)
+	}
+	return nil
+}
+
+// state is the lifecycle state of an incident kept in the registry.
+type state struct {
+	StateID      int       `json:"state_id"`
+	PriorityID   int       `json:"priority_id"`
+	ResolutionID int       `json:"resolution_id"`
+	Modified     time.Time `json:"modified"`
+}
+
+func newState(incident client.Incident) state {
+	return state{
+		StateID:      incident.StateID,
+		PriorityID:   incident.PriorityID,
+		ResolutionID: incident.ResolutionID,
+		Modified:     incident.Modified,
+	}
+}
+
+// diff returns the tracked fields that differ between the previous and the
+// current state.
+func (s state) diff(current state) []common.MapStr {
+	var changes []common.MapStr
+	add := func(field string, previous, current int) {
+		if previous != current {
+			changes = append(changes, common.MapStr{
+				"field":    field,
+				"previous": previous,
+				"current":  current,
+			})
+		}
+	}
+	add("state_id", s.StateID, current.StateID)
+	add("priority_id", s.PriorityID, current.PriorityID)
+	add("resolution_id", s.ResolutionID, current.ResolutionID)
+	return changes
+}
+
+// Collector pages through new and updated incidents and publishes an event
+// for every created incident and every lifecycle change. The checkpoint and
+// the states are read from the registry once and then kept in memory, the
+// registry being updated as the events are acknowledged.
+type Collector struct {
+	config     Config
+	smClient   client.SymantecClient
+	store      *registry.Store
+	checkpoint time.Time
+	states     map[string]state
+	build      client.EventBuilder
+	logger     *logp.Logger
+}
+
+// NewCollector creates a Collector keeping its state in store. It works on its
+// own copy of the SES client.
+func NewCollector(config Config, smClient client.SymantecClient, store *registry.Store, build client.EventBuilder) *Collector {
+	return &Collector{
+		config:   config,
+		smClient: smClient,
+		store:    store,
+		build:    build,
+		logger:   logp.NewLogger("incidents"),
+	}
+}
+
+// Collect collects the incidents from the checkpoint up to end.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	if c.states == nil {
+		if err := c.load(end); err != nil {
+			return err
+		}
+	}
+
+	if err := c.smClient.GetOauthToken(); err != nil {
+		return err
+	}
+	var incidents []client.Incident
+	err := c.smClient.GetIncidents(c.checkpoint, end, c.config.BatchSize, func(page []client.Incident) error {
+		if out.Stopped() {
+			return input.ErrStopped
+		}
+		incidents = append(incidents, page...)
+		return nil
+	})
+	if err == input.ErrStopped {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, incident := range incidents {
+		key := statePrefix + incident.UID
+		current := newState(incident)
+		if current.Modified.IsZero() {
+			current.Modified = time.Now().UTC()
+		}
+		if event, ok := c.track(incident, current); ok && !out.Publish(event) {
+			return nil
+		}
+		c.states[key] = current
+		out.Checkpoint(input.Checkpoint{Key: key, Value: current})
+	}
+
+	c.expire(out, end)
+	c.checkpoint = end
+	out.Checkpoint(input.Checkpoint{Key: checkpointKey, Value: end})
+	return nil
+}
+
+// load reads the checkpoint and the states of the incidents from the
+// registry.
+func (c *Collector) load(end time.Time) error {
+	c.checkpoint = end.Add(-c.config.StartDate)
+	if _, err := c.store.Get(checkpointKey, &c.checkpoint); err != nil {
+		return err
+	}
+
+	c.states = map[string]state{}
+	for _, key := range c.store.Keys() {
+		if !strings.HasPrefix(key, statePrefix) {
+			continue
+		}
+		var s state
+		if _, err := c.store.Get(key, &s); err != nil {
+			c.logger.Errorf("Error reading state %s err=%s", key, err.Error())
+			continue
+		}
+		c.states[key] = s
+	}
+	return nil
+}
+
+// track returns the event to publish for the current state of the incident,
+// if the incident is new or one of its tracked fields changed.
+func (c *Collector) track(incident client.Incident, current state) (beat.Event, bool) {
+	previous, found := c.states[statePrefix+incident.UID]
+
+	fields := common.MapStr{
+		"event_type": EventType,
+		"incident":   incident.Fields,
+	}
+	if !found {
+		fields.Put("event.action", "incident-created")
+	} else {
+		changes := previous.diff(current)
+		if len(changes) == 0 {
+			return beat.Event{}, false
+		}
+		fields.Put("event.action", "incident-updated")
+		fields.Put("incident.changes", changes)
+	}
+
+	return c.build(client.INCIDENT, fields), true
+}
+
+// expire removes the state of the incidents not modified within the state
+// TTL.
+func (c *Collector) expire(out input.Outlet, now time.Time) {
+	for key, s := range c.states {
+		if now.Sub(s.Modified) > c.config.StateTTL {
+			delete(c.states, key)
+			out.Checkpoint(input.Checkpoint{Key: key})
+		}
+	}
+}
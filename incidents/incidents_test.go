@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package incidents
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func TestCollectTracksLifecycle(t *testing.T) {
+	a := assert.New(t)
+
+	stateID := 1
+	server, sm := inputtest.NewSESServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sccs/v1/incidents":
+			fmt.Fprintf(w, `{"total":1,"incidents":[{"incident_uid":"i1","state_id":%d,"priority_id":2,"modified":"%s"}]}`,
+				stateID, time.Now().UTC().Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "incidents")
+	defer cleanup()
+
+	c := NewCollector(DefaultConfig, sm, store, inputtest.Build)
+	p := inputtest.NewOutlet(store)
+
+	a.NoError(c.Collect(p, time.Now().UTC()))
+	a.Len(p.Events, 1)
+	action, _ := p.Events[0].Fields.GetValue("event.action")
+	a.Equal("incident-created", action)
+	a.Equal("incident", p.Events[0].Meta["pipeline"])
+
+	// Unchanged incidents are not published again.
+	a.NoError(c.Collect(p, time.Now().UTC()))
+	a.Len(p.Events, 1)
+
+	stateID = 2
+	a.NoError(c.Collect(p, time.Now().UTC()))
+	a.Len(p.Events, 2)
+	action, _ = p.Events[1].Fields.GetValue("event.action")
+	a.Equal("incident-updated", action)
+	changes, _ := p.Events[1].Fields.GetValue("incident.changes")
+	a.Equal([]common.MapStr{{"field": "state_id", "previous": 1, "current": 2}}, changes)
+
+	var checkpoint time.Time
+	found, err := store.Get(checkpointKey, &checkpoint)
+	a.NoError(err)
+	a.True(found)
+
+	// The states are kept in memory until the events are acknowledged.
+	stateID = 1
+	unacked, cleanup := inputtest.NewStore(t, "unacked")
+	defer cleanup()
+	c = NewCollector(DefaultConfig, sm, unacked, inputtest.Build)
+	p = inputtest.NewOutlet(nil)
+	a.NoError(c.Collect(p, time.Now().UTC()))
+	a.NoError(c.Collect(p, time.Now().UTC()))
+	a.Len(p.Events, 1)
+	a.Empty(unacked.Keys())
+}
+
+func TestCollectStopsPaging(t *testing.T) {
+	a := assert.New(t)
+
+	p := inputtest.NewOutlet(nil)
+	pages := 0
+	server, sm := inputtest.NewSESServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sccs/v1/incidents":
+			pages++
+			if pages == 1 {
+				p.Stop()
+			}
+			fmt.Fprintf(w, `{"total":9,"next":"p%d","incidents":[{"incident_uid":"i%d","state_id":1}]}`, pages, pages)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "incidents")
+	defer cleanup()
+
+	c := NewCollector(DefaultConfig, sm, store, inputtest.Build)
+	a.NoError(c.Collect(p, time.Now().UTC()))
+	a.Equal(1, pages)
+	a.Empty(p.Events)
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"incidents.period", func(c *Config) { c.Period = 0 }},
+		{"incidents.period", func(c *Config) { c.Period = -time.Minute }},
+		{"incidents.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"incidents.batch_size", func(c *Config) { c.BatchSize = client.MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvWtzIzeyIPrdvwJXE7FqeanSo9XPjdlzNVLb1p1+6LTk45lZb4hgFUjCqgLKAEps+sb97zeQiVc9KFFtsd2e1ZwTbpGsAhKJRCLf+Rfy0/HH92fvv/+/yKkkQhrCCm6ImXNNprxkpOCK5aZcjgg3ZEE1mTHBFDWsIJMlMXNG3pxckFrJX1huRt/8hUyoZgWRAr6/YUpzKchBdpjtZ9/8hZyXjGpGbrjmhsyNqfXrvb0ZN/NmkuWy2mMl1YbneyzXxEiim9mMaUPyORUzBl/ZYaeclYXOvvlml1yz5WvCcv0NIYabkr22D3xDSMF0rnhtuBTwFfnOvUPc26+/IWSXCFqx12T7/za8YtrQqt7+hhBCSnbDytckl4rBZ8V+bbhixWtiVINfmWXNXpOCGvzYmm/7lBq2Z8ckizkTgCZ2w4QhUvEZFxZ92TfwHiGXFtdcw0NFeI99MormFs1TJas4wshOzHNalkuiWK2YZsJwMYOJ3IhxusEN07JROQvzn02TF/A3MqeaCOmhLUlAzwhJ44aWDQOgAzC1rJvSTuOGdZNNudIG3u+ApVjO+E2EquY1K7mIcH10OMf9IlOpCC1LHEFnuE/sE61qu+nbh/sHz3f3n+0ePr3cf/l6/9nrp0fZy2dP/7WdbHNJJ6zUgxuMuyknlorhC/zzCr+/ZsuFVMXARp802sjKPrCHOKkpVzqs4YQKMmGksUfCSEKLglTMUMLFVKqK2kHs925N5GIum7KAY5hLYSgXRDBttw7BAfK1/zsuS9wDTahiRBtpEUW1hzQA8MYjaFzI/JqpMaGiIOPrl3rs0NHBpHuP1nXJc4qrnEq5O6HK/cTEzWt74Ismtz8n+K2Y1nTGbkGwYZ/MABa/k4qUcubwAOTgxnKb77CBP9kn3c8jImvDK/5bIDtLJjecLeyR4IJQeNp+wVRAip1OG9XkprFoK+VMkwU3c9kYQkWk+hYMIyLNnCnHPUiOO5tLkVPDREL4RlogKkLJvKmo2FWMFnRSMqKbqqJqSWRy4NJTWDWl4XUZ1q4J+8S1PfFztowTVhMuWEG4MJJIEZ7unogfWFlK8pNUZZFskaGz2w5ASuh8JqRiV3Qib9hrcrB/eNTfubdcG7se954OlG7ojDCaz/0q24f1f21F+tkakS0mbg63/nd6VOmMCaQUx9WPwxczJZv6NTkcoKPLOcM3wy65U+R4KyV0YjcZueDULOzhsfzT2Ptt6mlfLC3OqT2EZWmP3YgUzOAfUhE50Uzd2O1BcpWWzObS7pRUxNBrpknFqG4Uq+wDbtjwWPdwasJFXjYFI39j1LIBWKsmFV0SWmpJVCPs225epTO40GCh2bduqW5IPbc8csIiOwbKtvBTXmpPe4gk1Qhhz4lEBFnYkvX5876YM5Uy7zmta2Yp0C4WTmpYKjB2iwDhqHEqpRHS2D33i31NznC63AoCcoqLhnNrD+IowpdZUiBOEJkwarLk/B6fvwORxF2c7QW5Had1vWeXwnOWkUgbKfMtJPOoA64LcgbhU6QWrom9XomZK9nM5uTXhjV2fL3UhlWalPyakb/T6TUdkY+s4EgftZI505qLmd8U97hu8rll0m/lTBuq5wTXQS4A3Q5leBCByBGFQVqJp4PVc1YxRcsr7rmOO8/sk2GiiLyod6pXnuvuWXrj5yC8sEdkyplC8uHaIfIJnwIHAjaldwJde5nG3mSqAunAC3A0V1Lby18bqux5mjSGjHG7eTGG/bA74ZCRMI2X9Gj6bH9/2kJEd/mBnf2upf8o+K9WvLn/usN1a0kUCRveW8C9PmEEyJgXK5dXtJZn/7uJBTqpBc5XyhF6O6gJxaeQHeIVNOM3DMQWKtxr+LT7ec7KetqU9hDZQ+1WGAY2C0m+cweacKENFbkTYzr8SNuJgSlZInHXKYnXKaupok4EccvXRDBWoP6xmPN83p8qnOxcVnYyK14n6z6bWsHXcx5YKrIk/5WcGiZIyaaGsKo2y/5WTqVs7aLdqE3s4uWyvmX7PLezExBt6FITWi7sPwG3VhTUc0+auK1OGsd37W2eRdSIwLMDVuOzSOJuigmLj8AVxqetjY871iWA1uZXNJ9blaCP4nQcj2enbG4A1f/l1Ng2sjswPc/2s/1dlR+mYoxuyTCNkUJWstHkAq6EO+SZY0FofAVvEfLk+GIHD6aTThxguRSCgcJ4JgxTghlyrqSRuSwdpE/OzneIkg2oi7ViU/6JadKIguFFboUlJUs7mOVuUpFKKkYEMwupromsrRoplRV4vI7H5rSc2hcosfddyQgtKi64NvZk3njhyo5VyAolMWqIU1txEVUlxYjkJaOqXAbsT0HIDdDKkudLECznzIq+sMBs7QtTNNUkCDS3XZWlDLd2ayvclYDjWD1U5iBcOYh62+TkjfB1IHi3i26gJ8cX73dIA4OXy3jjaBSeA+rxTJy11p2Q3sGzg+evWguWakYF/w3YY9a/Rh5MTPiQzANT92D7XkpLF2/fniTnIi95R74/id/cIuAfuzftAfA0QrUjCm64pU8kR486dywseFMZVFgU3BWbUVWAQGflNSn0KHkehbkJRwsYl1YjnJZyQRTLra7TUicvT87dqHhbRDB7sNkv7OMJZHAoNBNBjLfPXPzzPalpfs3ME72TwSyogdbuWPemQkuPFbdak3r9Q4EZi2kLh5OQPZaMokJTACYjF7JiQWZtNMr+hqmKbHnzlVRbUdtVbOo5iANFdBao8Ti4n51uhjs7YUE3Ad0sQYA7KhYsMfPbHKdI4Uct0xGRn8DeKI1uLELcqFEp4sKC90sjcANAR0KtxxsXBwaL+BXS9Ia0wg7u1y6cMm/VCbYgHG/PzxOsd3B4UHyiRUE0q6gwPAd+zD4ZJ2mxTyhDj1Cw8adUB3nLSHLD7XL5bywqvHahTIESrLlpqNuOsylZykaFOaa0LD3xeS5tOdxMquXIPuoFBW14WRImrMrn6BZNhlaYKJg2ljwsSi3CprwsA5Ohda1krTg1rFzeQ9mhRaGY1pvSc4DaUbN1tOUmdDJJYDPVhM8a2ehyidQM7wS+vrBo0bJiYColJddgSzo7HxHq7z6pCLXM/hPR0tJJRsg/I2ad6AS2vCgtzxlRdOFh8nQ/ztwXY0RZW/ITVjGOgl3RoC0Pr6txxuuxBWWcIVjjESlYzUThRG+Um6WIQICa7XYsSjbZ/3GXKtXZV3qvRhgnS8P0HSJwsh9oCWm/1gLkb/YHtIIER4Q7J26bkJ310ffyqAUYEtsGhHPHV3H8rDXnjMks52Z5tSFF+sTKtoO7887K0oyWfXCkMFwwYTYF0/tEqQ+T9eB7L5WZk+OKKZ7TASAbYdTyimt5lctiI6jDKcjZxQdip+hBeHK8EqxN7aYDaXBDT6igRR9TwLLuVjpnTF7Vkof7om1El2LGTVPgHVpSAx96EGz/v2SrlGLrNdl98TR7fnD08un+iGyV1Gy9JkfPsmf7z14dvCT/33YPyA3yqe0fNVO7/o5MfkIp3KNnRJytACUjOSUzRUVTUsXNMr3sliS3ly6IgsmlduLvsmCJQQrnCqWcnFku7gTiaSmlcpfBCCwPcx7FzXhrIHglqedLze0f3hOQ+2OtExDeS5N4O8HPwVE/r+DSmjHpV9u3V0ykNlLsFnlvbxSbcSk2edI+wgy3HbTd/zxZBdeGjpqDafCk/WfDJqyNKF7fAUN4oE2cZ+dBcPIcES6LlLLQaOkNHt4Fd3Z+c2S/ODu/eR4Fwo4MVNF8A7h5d3yyCmrSsg2brIuXwWO9AjeXVuVDzeXs3E7k5HiM33h/fBmUYvKEZbPMWV1omSrvBDVAb5BpuQDCWUn0QKtogplOzEgpaUEmtKQih6M75YotrBoCereSjT3RHYzbRddSmfsJnV7I0UbxYUk0xYYd/8+CD9Q37yHvtVZ9jm9/lnR32IajtyfrCJ2r9+Pc7cEq4rfcSRumWHE1JFc+3PVmFY45n82ZNsmkHkc49wgWUtes8CDrZuLF0bD/30VfCF5TyXBOP5xKRbamUmYzkO2zXFZbVsPfSj53XTQYdeJcLwUzTFVwFdeK5Vxb/QdsGxQ1UnBYQrRNMyl5TnQznfJPYUR45sncmPr13h4+gk9YvWcnI5dqaSnVSFTmP3F79eH1OlkSzau6XBJDr+OuogZbUm3A/o8hJ6gsC2kIKGILVpaw9su3p9FJupXLrLne6t+lERktkjCyvoLt/wIUwaZTe4BvmJ3VyTRuD5+wy7enOyP0elwLuRDectUCizjUj7yJEFBU00j2bjy4IvvE0503DGvxGDEE1PPnJhsgmVUUEzdiPdqB71tk02imss1STKqRoTFZKjTR2snRl1MxMF3I6SqOQQV5e3p8DiEDuOLTMFRKKtv91bGK8nJDi7PiP4EJvMyS9QGYNmU5IEk+KBDbmthpYFoQ+ukN5SWdlH0B87icMGXIGy60YW7bW/CCPfIPIwqYffNUgYvcWPxIP4Zi6uKFcH3ezQuWu726pMZKBQPEg3BukHrSncDJ+kDMqZ5vTINGTAEvsPNYPplLpZgVR1vBSlM0IAPTEIQKKZZp6CMKVgmp/KiZC8QYwyp4gYZf+GBXNw4BcrkUU9wrWrbmpKKw10R0eBAf0DpEVBuJx/nQ0c2aLmkFPQlg6EO1ISX2Ym6lVLRGQPAaF31AEr5Dge+0vKCywSmDE9R/sdoHinHsBMkj2MphKAKOvamiIbg1hu2hMwNjXrwYDpEvZGWY3pS8Y0bxHMNndBqeQwV5c3KIwTmWQqbM5HOmwRiTjE640S4yMgJpqasd0NuKzOQ6hH20QXDjqka4kEvFKmlCkAiRjdG8YMlMXcgQJkpcTKBfkN90EV91hqR27DEOGgeC4Ec3uVeV7LBcR1Adwu7j7srBzLk5zrx9GRGEc0HQZ+pw4EUI5HWnbEkKPp0ylSq6YC7jEL5q7yp7PHcNE1QYwsQNV1JUbVtLpK3jny7C5LwYeWcG0D/58PF7clZgqC04vHsHvi/YPX/+/MWLFy9fvnz1quOzQTGAl9wsr36LXq2HxupxMg+x81isoCsNaBqOSjxEPebQ6F1Gtdk96Fi+XHzU5sjhzMfFnZ167gWw+kPYBZTvHhw+PXr2/MXLV/t0khdsuj8M8Qav7ABzGsHYhzqx08GX/UC8B4PonecDSUzerWg0h1nFCt60ldhayRterOVU/d2+IThrfsLMH840rYQu9IjQ3xrFRmSW16NwkKUiBZ9xQ0uZMyr6N91C98w1XR/Jgy3K2ZI/87il1zEyeod9fyW3vrwlNCk82A4/cYEhvayfJBGhZjmfcm9KDlBgdIUzDzhjpJymgyQpZEwzP++clXUiQMJ9hUbMMLR2N6FYWgQZHjSEdS6ojch4TgiOi+dF+wzzis42ylPSswGTBQ8qArSgmkwaXhp7nQ+AZuhsQ5BFynJw0VkbgCSv7fbZk/y2WzLcuswWJnXJYq15N7gbcc3RRxS4CZLsptgJjk4qKugMzFYQ2+7h6XESzKtL2EgSBJUyktPO17ewkuTR24PlUHpOnganKzoF9tr5ZQNjJvFxd0XGIfdxkXFfY+hWK/JsrfitKMZiSuoDxW+FYSGO6zF+6zF+6+uL30oPi3fzuZzwLg6/VBBXyp4eI7keI7keBqTHSK71cfYYyfUYyfVniuRKLrE/WzhXC3SymZguXtvZ0pv+jkAm1opgqhW/oYaR03f/2hmKYYJTA7rBVxXGBXFDib3ErRSsKBE3RpLJEjBxyqA4wMOvcBOBWfcQ275cdNZKWv6jQ7SKnkT5GKf1GKf1GKf1GKf1GKf1GKf1GKf1GKf1GKf1GKe1VpxWIVplXE7fX8DHWzw437W8NvZSPX1/QX5tmOJMw15RoRcsqRRpf3eBWs7yzzgEv4QyAbHGih9radU0e1olmTGDVRJwWDfok3EhNIQ9vIbnxzuuaNvST5KODnzZlxlAgorl89yIOG1wQmm84qmG0py+PA7CgP7rBVPMRxkUjrdwjeP0ocRXxzv38TG1Vvzg3s/tY0GoUnTpkYFYdu+jcEOtNANgEO0qeihmGiWSI+9rr7p0mkTKYwT4/zVbOpRFz4/fG9wCzXwZ0JZja7Ikb04uYpmmj1ieBMea0xuGZXxSZlHF5eCPfnJBFvatNycXbviu3cxusyU/sNWh9olVsuCXtnPSPufJnBwbUnHBq6YauS/DuH5RVaNNq2Lj2M4ytsBBKGBvGfbu9dLDiFS0DkNSO1o+h3gJ46sGU01qqTWf4I1cQLUNKpb2X+4LvODB9R6sYUCpJjlWUGt5RDsUmeUl3ZjvE2P4KNqUwoZ4L3WBFMOh0B5aQrBoTY/Xnb0fBD2J49yIYgbQJtwR9exOYWJ3OBjFIEpv/cVXayYK7aUTiLoChuVRkg7o197TMg72M///g1jYpLX9sq06WopLwpc6oJMaS7jodqE6SvI5xcvs5P3xuzf2QEyYRZZ9v7xhxShlTtvbmoxRnIgsxiSecCl8oT8r1uhaWhSDfhkPAwwC5zIjZ4FXWY3P6YfdMX0x3TGUHvJu17G9eRjUwe5ty2KxyFYYD/zOGLOOorTKvGZxDzEeYPm8AUnKcm5YLyBgcBMs15xYZTyfp4ydTYEvtTz2XOdUFazIyL+Ykj6mzpKyH9+dgQR/k4g0nGLAGztMpxuMa7ycx5jGz2QxQJotuOeMFkxdTUtfjHgD5+sY7mw5JYekZMYwBVwSZyYwcyswucbSeTH48TU5Ph6Ry5MR+Xg6Ih+PR+T4dEROTkfk9EOPZN3HXfLxNP7Z9npuTIGzO2SXhhbnVJGjWvOZSCqsKzlTtEIKDFXhW5YcEMswTCMZCOKfah4jO5A56L7K/vzw4OCgtW5ZD3jDHnzxWJvQygR2MidGYVwlQ7vdNRdg9kUBtiXTklBCO7W5Qe1f43EXC5+hOxSHQRkZMAPluNMxV+LoP3988/GfLRwFzvjFJAY59VXs3IWBqsmd8kGLh2/yaoQ7sQNaevUF73EnR0NIsVsrLgyUiM3nFJooKE2eTFgpF+TpIURxWQjIweHznVFC/lK33ojsPChJWG2Q6ZzW9lhRzcjBPtwiM5jj59PT050oif+N5tdEl1TPndL3ayMhGieM7IbKyCWd6BHJqVKczphTHzSKqSVPYrmmjBXpCLkUN0w5r9bPZkR+VvjWzwJIEM2u5UCZ2luu2bDNf7gT59Fx89U4bgJRBORvkhjCJKDlReOCW2CsWtsj0T6jcAPNQSt0xikAGnhhmGkUUaObyaFd50HmsAKkMWrhPEKIPMidSa/AxjG2RkgiQhKjKC+hoC1TXA7LvsNIf3SbIft7dJvdy20W6efL6AhOVbpdqDg+Pm4Lx15dvfo9wS/HPStdWZKzcyvGMUgPGqfWjXHHzOB/HHtrn6MdPp3yvCnBiNRoNiITltNGB0/EDVWcmaXXj1JCrajRVi+0QzmwMvIG+zpF+JJwdQ+owY4bkoBhNEHOOEqs0GWEm2DRwrJDBftk364slaRDo0iAL8HvjGor2RsZRoy1Y1FSsfLtVPZTLYOC07WetL876G4wCMNfQhfwcw3HyL3/8Objxw8fW9Bt8Gxsp4cj2PhJTmvoPTRyiLYyKdBf+/KCEr0x9SvxEUhRLsHuqqE4b+JdaFXrhcdyxXyXMoBPxM41U4St6yZYF4oIgLf5O49AC4jO/NA5A7BQM+XW/0TWaIAtl3YILWW4V5zChqdjJyPHooAU7lyKqLs6rLbP/mpfhTfpW1XO8YQeLw2239B0JW95gbDN3G1eoHfM0N3UXu0z/ZxBev3y9Xd1NhhoT/f7er8krfvgHgv4tYvRxMiMjFmuM/fQGN3gHozIBEEwAtbTaIP9UsAlWvaqYxPy05wJ3DPYQGwUE+Q1LgqeM012d52d1PkwoNWWkUSXfDY35VCeerIaeN81N7SglcyyaKu/KVeFmxa/WFB9fF0+ZxXt4J+0OngNkM5Btp/tp5SjlGwllb4JX9zezComdebQ+cT7g2BAjeS7BNNGwOOPWK+9QvkBn3OeoLpmkB1UMqyKYNHsGQF4qnNqb6HQ7+mb9Gxxo1k5jYo2FTj6PTx1G4qKBmSi3afjUUAAbzXDPWTy6kAMxQAEaZO81WCERnmDi/X2qtbA2tD8+spKF5u8YWEWArMElwys0hJQXYLrjn3qlOv7QsJnwPgo7Tzkst2p1q1yAexTzuoYtpoc31/oDc1KKmbZ+6YszyV4Cd74x9NzfdNpYvHmZs0mdXimhhLFfUH+4VzxUnoVAnPKFc9b5zOwgWPoe9jukmGPbPeeTPrCQfLjHM8OjW3ePHrexv6MwMx9zzrjnSnUBA8WaD9iFseIre7kNFmEG88PRX3rNALdwXytGVdBJvb0cKZuVDJCjLQb07ulQR9Lo4BHmL850BhkwszCit40dABwMkbSBQ8ncz01sPldXkpt13bsd+JudGNeghsSu+s0mLlVwojYcQE+ph0EAaBhRCePuWFjD74W1lNqiSivWCUhjoRp6OjghisSxEeCu2lKwRQWOeGxyaF7WOdU2KVDi8P71LtZI+vqs0VvHD3I296c386NdkaDkFeENQDSQIOkhS+4PbnG3YsS3ZwKMsYHfN+McbQEh42wZ30MCNmlRTEekbEj+V0geQZfTXnJdlFqLsbojfE+iTBi6KyXhIFg6YK6BGoYqpLTaKZ2a6q1ReYuBvq0r2gH+ia2443TfHCGLvKDYDHns7lroDLMA4FDeu2lsytRP5a+X0tnc5AgxiO/p5oJ7RxGMSeMBjADXHFkL5FS39rmJ6rs4YbGltMGym4FcVNOrfg5IgtmL0eBqTUQDEVo28Bkhbnc3jHguXCOyBAv5VrQ1tg+u9EMDVg5bYbT1GCnoYRBZA2r5bCHU3fPnAyUJ964sAjXwLrVPTGhgySd30cW2YV6Jlpg/+9QkCp0yW1Ekts/cl2dylh3gCD7w16+9l5v7B9SEbs80DVA5kdOK2+YAjZrNc0gQnhJJ6EwSzw/cVHIhcZ7n5yd9vfh6PnRyzby8VjfccCKqDC38es4DA7Sq6I23HPcXgjQhjvArhgFhuEbOGKnqyVq+r1G3O6EosZk+SS3d2ruMpNi6/TQOCj5yqRVr01qyQ3X2UCn8xA00uXTZ4JUUpukldHIRcaZhYxdyp0DZMIG1ELkp/5jngZdtHp157TMoSSGS3MqIfoDBYXUIuIc6S4sEEk8jNm6t2Fb4FXfo1hp40UeVhDeaaTpIamk4LGNF0mG2N4G1c3vmP3oS5AZSa4Zq0lTI6eAl9LD1cYqNHYESNt4tPcVnriclqN0Z6MLciDIuKCGanZX0tnvD8jHaTpRUaLdyx4s9uCCrbAiBxUY6eS0BisoS+UFI0yJtJw44R+lnI2cllPK2c4ondyeCL9TKA4sYwmO5BTmskoylrtdR2ErFctlVQEnhpanQppgU4HhrYjQmhsUmhChVcmiSTqtYorFVJalXKCAQEkhsRaj6A0zYAGraT5nWYKLsL2NWidXfiCpsPMmF3VjrvyPggrpwrC80NmY9AGq3/Gy5IPPoGsHaORgkHBO3dQtuYGADypM26Yk5D6IdXuS8TOzyoFizvtlorupFVQ3xGE8+4DZBRrG3J7yXvIHE+tEDK26KCKovTuiez0gvdnr0H9vJZubNJ3f3iDgrXKtwTu1uTaYdfED1XPypGZqTmsNDcKhcfaUixlTEOixA24nunD3k5F2Ayh6RMICClZJAU1JGSrGYPLjZjmQOuuLGw79dfy3k9MvZk86O7WrCZWfEr2lA/Ng7+hrvhYBfbZm5QOqVqpT6Bzoy/ALJ2t3q9m1eCXSbLxILY+zLzudPzGk36ISdNQu+HYcxxxrQw2zChctqarGX6ckD0C2LYgpm9/Y3YqzJDHXt7XMBunCySkgCYGAo5u6lspov0cWJyCLw9AoupTNDJiT9IJQGDb6qKjrTe0udLyij+F2ApawM/LaHY487sRitGTOaAMEJd4+v+rqa2Hdy6SbwPtHugCradBS5BRKmKhAyj86CeMWRrZCWrdCBDiGGV44hcyvkhqfBdeWTAtQoDGBDORmRlU+Z0U8LVYg4aEHvGJGcXbjhfbxFe7NuI/KC1aTg1dk/+Xrw+evD/axMufJm+9e7/+3vxwcHv2PC5Y3dgH4iZi51W1Qc1X43UHmHj3Yd39EtiBVRXQDEsq0sWqGNrKuWeFfwH+1yv96sJ/Z/zsghTZ/PcwOssPsUNfmrweHT9vVEmRjrKy2Sd7ppljFPs9SASVapay2lqMlM3IS3b7gWyMnfdZ9b99oEcQHHWt0KBwDhYynlJeNYoMMMYy4FmNcnyGGcddnjE1fMN1w/dzti+AFH9o3NANAoRHkez5g52KpnZbRtxq8lbNES67ssZdtjhVd71618Yd1oI4S0XJqFtQ35x0O80bKQj56sdTQgH1uTF3sYNVt6OfeTFxZPjewi7G2129sXm//9+SaKcHKEXnHcyXt/Ltuibv+cO8eNwW37+709xHfbm2j4vr6Sie8dRW3nZaSDvrJPnJ9TWAEuGUUl4pjlE53/dqBSLQsgdJ0EsH7o2ZO2Yclg7rtTBMo88+Z6lYnDbBfCamqNShx5SK234ORl//GChj2jgWNgh0eLFZhEfv2SB7s73evCKi0zwXWunEJyEvZwNFrq8qOEICiMKtAJwDptr3DDrGg2EFMM8sERFwGYs0592lZ+j7jHeVHs1+bRHV6uAJBF25gX2typQDLAgz+UQhxQPi9SQGUat0zW47AakOv25lQ7BPNDZGqYMrlszkJJ7FfOutlmRSLihaXoOH2kHXDkuprD1LiB4Pw0TcVJmgfH5rnzn5q5K3mpZ9CxpO3wcUR08yoJOQOn/L6srcG0yTixxIpxCtkznjS1F4bSFwgYSPAueVm5cw3wxCaa5OGijjCdBsT7JHa8tfB7ETH2cN6JsyiGeq8jks5yzT8nvnfs1wW9l514qr/Osb1ccSFwb76Pt4DHRVuihbe43a0hGNfoiqezLPTi52sLVm4NwrJUEp0VA1NO+RChBkxmKuiSxKjtKLVVNboeFq9XIhk7Cy4fw28aNO0oWuVB7vd/oHGlTstIM71ltpAWoLTjUV7sKKvMILYc7rB/hLbiVSfJIiHss3tJdkDERmH3eFoFZSJ797B3NbSS8VosXSUVLApbUrjCT2ahpNbEg+gJw5s2rHgOj0rx1H+C5P6EFnItqP2+EsBru+zUzf51ptGyZrtHVfaMFXQaitJ2KGTiWI36I33j19cbu1gMCX54YfXVRWZCaelf2p3/9nr/f2tnQ4b7QepPJByx5BcQOJ1VoUGw3fCWs5R6KU3ElqvhLLjuN/2RagmYvVwgNrDPOXOEOACUL7zn2+JPzmGt7rBCpDs1jPIQByIJhPLhdueKxdPYX8FR56PArBju2rPfnkWqJA775g81VrmuHcg5YNWiGx3FEI0/Gcqij2LO162YtKcsX7kUrdqJYsmxzsZpjzzujF5Fy0T/+u7s3f/2z0LgW9uRNe8R+9k+LJTrrwm0y+7TiE2326rfbyzHk81gcWEcJ37dQICx9DvYIPbbyExiFeoJwColpH5odvVHZzOIFydh7iVGn1JRtH82mtzWg9ZrQfdm/cDGdAP4wAN2jnWhTLWXG+/34Fxze4B90EqNUbxSWPQqlUxQzFbGkIshtGMv4VaEzCMM2Si+7Kp4bIaV3aqsfMNWuHGCjBjWMU4MZCiwxN92fZQmyi62EdHRHMrzbrhQJwVEW4v21kwus48KJK5oXsNK3Cu6HUSAOrp/p0CzqEy16agDNW6QnRs4KKu6n0Pxr25rNgeLT3ugmPHAtUP534wWOH8hEl6YNVO4A8lgjeWmX6ueEXV0hUSs5f692enO7fu6/bB/v5Bp+x14JGbhjC1ogxC19/LOdXzrCqebQi+d6fPcIr+pHpODzY068UPxwe3THv47PnmJj589vyWqZ+5wrYbmfrZweHA1FxsLlrqzI4d1Twfto6MRYS/vTjVPSuHz54/ffm0U8N6c9C+s8Amx8OCKHNDy7gCOhhPvb3//Gi/A+bvvIIHbuBwdVJw6/Ap72poX6g2ocON1bBCIoLnxqPgyDRpPckeynzWcZdZy4XYmHEbxXQ7wTZEtKjBmu59HlhTsynv/3dNWcL4qZB020W7twpxmv92T2PigFBqB7FUD81WEpnugyiXRLGS3VBLgFYThxheSKkDSWvLfhxI2D14/rTTYcVQNWPmaoNIvYQZEK1Ws9TLquTiWn+xlA3AJQQAPLFoGdlzAMqkg2Snt8NB8wvlIjdaTgd0bSuv/Ajyioo+giTF58lFR5jBs7NapEl6MqAKiCr79+7jLRr790ymeWA5VWqZNs2lMSDCN65I+wNTL2m2rdwYpBF7XbRU/5A6r3hw8hqWzyEyJTq2LGRn50mKAIYDql3d1FZPKe6THvb1tPf56lv7fIVtfb6ylj5ffTufTVZQemzl8/mtfL7GNj5fQQufvjru76/wxeob7DKUE09SHgf8XPCMy1e2j3iZyi9RdoMg17lX/n3rw3/VReG/dCX4XjCyo88f/Oc7UnLnGFcM5BkpMjqj4XdazqTiZl6FlEyunA87cXewskBO5TJ6q0pC9ak58/kF706fjcDOsgN0XivmuHVGjovCgzEN3gnsg++GmCxJKRdM5VR7BbMNHDJjCyC6kqBYFsaOaFZTRY0MBbOpxqpFteLUMPJEC3qNnvURwfiYOX169ezg8D41ub+0RezLG8P+GDvYlzSBhfMkdSvH/Qf/+VYXo++/3nIxYjBaaU9E3RjMp8ZG/uHwvDm5wATib/0hGHR2czMfcMnBpDL2gW9XsPDJ6KBqgkIzmEWd5k/btQJGQ8K0G3FOVbGgio3IDVemoaXv869H5BQaQifN1rH40t+bCXRZg2CLgt2rjbLK59ywPIm/fNC+DZ3AvtZ8PYng08vnV8/bNovH5qyPzVnvD9K6mtxjc9ZHje6xOeuXaM5q788NQbL9gxvb80y45NME2FjRIsTrLXzg6NhDNgZp2p5fVyHZqyJw9bs7+A4t6WHW41QklHPSAI9jHfDo029ouaBL7fohjSB01cW9Bk3XdbmAKGyXJM7EDVdSVJ0cA79/UM+7UaCbND5paDxh1GCDhS4WPq/xLkhAvB5uGreZhrk/uK0cnnNT9Pn+VtpMSngiVSYUmVDij4J/8hHtjklCUtKvDS3BIRnGTJR6X5cIYoxdzfpQzgUaVLlwdCh5XLCcF1ClzcquQEaRsUOJ0s7GS51NacXLTYXGfLggOD554r0CihVzakakYBNOxYhMFWMTXYzIAtNC+g4efLIHd1Nuqh9iT+bFnWi7bX0JRF9eblgEpbnFwTv5C71h3RUkuS1fYA04WwAbdC5FFy7Mvwf5UXaU7e8eHBzuukI5Xeg3KNCswH/qHXfLWIXwf3Sh9WaoLwWxn8/RvZWNpB6RZtII09xG61QteI/WB0t8bg74dWnkYD87OMraxXw3FSh96XLCO+z3O6nISSmbImT3aRQ1kwQ4d/OjVxnKeY/NYVaxgjfVGNIebqq0ejvkMieyblDWW5UDMRkOTG+t7mnhrg4jDt3ZnbaL9ZohL6tCEC5CfyIndYTAbN8JM922p4fP2tM/ts99bJ/72D73q/SUPLbPfWyf++/cPnduTMtj/MPl5Tl8Xu1B+M774UIQk30pJONlvsw1GTeqHPu0OIY5xyZZtQVSlbEjJPTDWN937F+YyGKZQdjf/W5wn2ibvtpGbhpS2AGTwKxd9L58+WI1iC4IdkNn+NIptLgZt0L5AytLSRZSlcUwtBvA5aU0tGwHaXYx+sQCC4cdOwEOiOcHR0+HEVwxM5ebuke2WyjFqTqpxkjkmIAOFZgnLM2sNzJ4hbHkpi+ln5EL5kqSybypfJh2GNu3LN4683nTVk94c3Ix1BqKmRGpoRxz3ZhBNCk2ZUptLEr5oxs+1g9JMdfbTct79Ou9vUkpZ2kvp70O7K5X35c+565TyZoHPQXyy5702+BcfdQ9vF/6rDtoP++wO6C1oabR6/aruVdthTZOcaJhn8HRftvRulkjAcC1yupyAEaAGF05S2/0t+7jLSEBpz1vfUhUL+VsZllOxfI5FVxXTs6AL0M1nSRuGUpfxQgBKHYTXEZ3Rgn0pnPjhsKvkMLqk47D/GlhuZZygjUPwkRYAcKPCTbbtDTCt+PWQvxbaUW7Xi2NzgqFNLAIVqTjfxsq200aQxR1ZgtfeeHbsWvygfaMNycX7ebl60hDQHAbkDC3P/iiOhaRwXfpNmtVeSzdr8XkLUQanI5hKAXF1RrLMEJJC3t1hBFd4mnofz2TLJbwgEHQiJTW/S0k02J724Sir1KwaGLyFTPqxqT7GajJ0n2o6AEppaEaU1pPZKdXHrtV0XBBlRiPyJgpZf/h8J+o1dByoM5GbEaTHOZZ975+kH297JSmwokIFxqKgwlC67p0pcKzUJOo0Q2QeVqFIx0FW3mg/wP7MTgBKMwwwl4KWGjAt+ofNN5LNctYSbXhOVa8yyZSGm0UrbO/+b9ayML6Txmk9ySNWW/tV4f9YVdhyI7SKUcUEtpc24iE3MER4eoLu57EneJeyZHpXieHK5eyQcNDlwoeaHFJJr0rzQ6MsVsOzb4wmDUWtjf7hd7QQcQ0YqA3xebw4qZzBQTmsuih4o79tadhYCGbqVnpj6tJ67Vb2HwNS9otPgwCZfJE2FjXQl/XJTcYFmhIA+XkgzGkpqrVK+AM/bGKxl5dYzesNwcg8lLPLRVJsXnXjLRHXW6UtMZip8SiW+yotyBfli+MOac3LNTTgTphmJma+2ZjmCSFHgsmcgmuR6mIYAvgC5ooVsmb9BBIkpeMCqh31Qb595YAJVq6Cp/2Wpsw378z7pP3zKXdVT+/EiiEBYEr490ySJQh1BUuwjWOHhaWcV/hh6shsu6dPXfVhlod7VJ6PBUrICTUXt0VNylHuuHUDZP5Ej6aMfLxuxNNnh0dHtmtfHrw/CgbWFo2pTmU6s82oWNsJyv0Zdz8hD3ZqutICOs7TkuNxVVZGrLLGg1XP6fCX3mhgtt+GNK+e/i0TxyHT2/F0YbvJ1/din0yuxMKvbjWRVZnHUDUL4bW4ms2PvhWd7Z5RW3Iz99iFofkmrwk30bk/PcgqWZt3hNrJkIzW+Dv7FONFZPA8u9YsqOeQCgw88Grg4Fc6afPhtDaqjV3P9zeeWK6hQ/vPjFDBfZcXT2L48gwUlUlJpl0J46cBrDUKe4HRf1GqVZi1Yoe8O5kzuRgIb5bQQ+1Ab2SQ2P3l3Z5QHsb3FYesFsoca2agIM8IWz4JuNtvwZiaBfJDKOuRQRQTXwFBSRK7R+4+QkUvX33/VFD0B8WhEtNTu+Tr+7I7PLl5NrpKBj2UVWN8M2qoCIC9H9C0ZHG3BeCQllSls6lk+iWNcc98VnJK370TvONbqG8UAL6HukjUcve1HE5Rk0Gy/RDyYF0VmeHqZU0Mpdlu8sRVRNuFFU8IRysMexKJkIrSY0ycgUVpl2pvhEIpLTU0Hi/XKIiEB/W18s6Mcnw/NeRvbnYRMrrETELK8spB8wibWZkNY/YYSop+XXDRJE0YoLKEABLrJdgb6Ei1EeIFWThSO0VTBtydo6lIvSIQJnwEUnGXHDlK2N+hf4fyqsWaQ2Y9tepO7zSrL+Ndn2054PEDd4e2JGJtOcG4j6g716Lz45ddV5405WxT3p/hu99354RGfvD6n5CUYXHndBNNXAjPe+0c0MOYpZXGwsx2T7GeAlo0YrmYAE5IH5x5Owc01EdNSWdzlMbmj9+Mamizf+iBY4SI2W5S2dCamNvPkNFQVWRtt8Lw05LuUg34y2jSmD9cGqC/23GzbyZgOfNEgh0ZdsLyNvlxa69ZAaEvtfzD/9dvz/64b+/+/7Zu3/uvZyfqX+c/5of/es/f9v/a2srAmlswNqxdeoH97e/Z9dG0emU59nP4mPSvStq169/FuTngJyfybeEi4lsRPGzIORbIhuTfIJOw4KW+MlSUPzUCCDcn8XP4qc5E+mYFa3rpJk3MB28vJwyk3Rmcf2FR+FCSuwc6ZiBc9lhtjWBtCq7+BvOFhnCsGJijxqpSM0Ur5hhCgFpAb0eTBGQFgT2XxB53GTpyGHSbKtvIQNst+hmKtWCqoIVV78nR+Ls3EcGxlLM7rgmPzl7Wa3kp4HWU68Os4PsIGtbaTkV9ArVqQ0xmLPj98fk3HOH96i5PfEnd7FYZBaGTKrZHl7M0Clzz/OTXQSu/0X2aW6qMqkTfeH4CNxXvjOIf0s7/kNLaC8AHAwknvfMfFfKBXZLg79cWFAYt5Qz7xBoXFzQ0Jp6CH/eQvSmY+9QOJosXSMNaMwv/e2rY6adv5e60H4PoSE/8SlvgY3NsO9xCQ9duG6Qz7py3bsDl278ZeDa9T9G+cxdwMMX72HbE+6pZgO8fvvtC69dxDsTvEeEfcrgRhuREijqF5pbSTK4iIOE+/VJbiEIL0Txe6g3gcILKEChAy0nTAyldghKprHWOSN/x3nSY0hCD4yA4ZIuLXNqinpETF6PCK9vnu/yvKpHhJk82/n6MG/yDuI3lD5xhpfOh4szKNVZ4iW6SNMcPFm/tVjMLO6OEIOJllRrlo9IzStA6NeHTgt0YhpwzRhUahv4kH53W5kKEV7vl8OvWc5p6Sl4FGoAYrpeT6XGItkhiKRghuVm5MdHjzQGltw54m77fnPCleWuWEJet0v4hUSW4Or21SlwUCpyhimGbqmdsv5STPmsUfGak0Q1Yn0EhI5TSXexdrUMb6vSI7JgE5B+uFXfuTCqgTQkRBeXYq9WsF4Y1ydSeoEyiozfeLoRWio3bApSMiP4dkqpNRka2mL1+PydQ43OEmOOJ43UmkOxyPoKY47vwAWDo1VQLP3RAqzjOnWgC+3DjJA2dJSeb8E3rCKapVxfAfLO+V1/bViDA5M3l2+h2IoU2GjIKX6u02IiuYdhQlkgxcD0Bz1yCmblAY8PiIx5c3JxDwvUY4GQxwIh9wfpsUDI+jh7LBDyWCDkT10gpFsfJNy+bWPI51loEgvMrcNvpqDFu+OTVdN/KQPE9kkMguyjIJHxvQEYHsQ2NejZSF074c2WI2fOynralGkCddQqpjGUK8hmQV6iGBjFShA7wpEWRKoZFfw311YgNT4ImcZ1QpATYwUrHOfBqC2Eq2RTQ1hVm+WAefkKTHEX37c24rFkxiDUjyUzHktm/D6I/48tmeH6zW0I1Mu5735nVnD4Doj6cH+/BZ9mitNys24Gb5VxkznB8K6uEw8VrOxqg3QwgzYpK7mCIaWy2z1VsmobcJWr5JXU6Q3uizjSsmY6G8rS8A4mNY5mtrG/BSFlo9DwTw3/wI0Ef8iyZJDYgXYO+1e0VQyEzfgxWyhtxSw8JFL/CwZej+AulhUVpiNNDp7fh0ml95uSMMQYEx9lCnjXGw27398RVZSO4w1ETCiez5GgwDLUKjEQQn1yWdVUeOnCikug8LSIsRP3k4YZ6dCd0opcEIBFlaJiBma+KS+NaxWKqfBemIIIcPA/tQsNBDDieu6TFPYHlNZoi4Xky4jQKX0EsSbeRi1SClfHReyUf3t1/A8XocOLi4wdJp1uE/71Sxn8KSXaP7k4+yeWZf9EguyfWIr96kXYNMrAp2w5LneefHUrc4v31WreBveTNrTEPCR0KPlZPXxnSS93X0t+YCj/2iiEYSKBJYdZ89/SUSGGNAztAMExnW8njgVdByFBPU8uoN9Xxv3hGpriyu9dwT2fs/xaN5s6QidueC8nxq12WwVX+w1TITeuH6vzcvL08FVBX7189ZQ9Pdp/9Sp/UbykxbN88ip/ddRWZ5LJN7Si07aFHYK62sQaIP9QMxGi/5WcKVqBnlFSMWvs2o0kk4aXBdHcvrGnWMnppGR7bDrlOY/OPhJdrW0RDNF5pXO5saZ9Z6KArREzMpeLdMGQHRd21HUNgSZwYNYfkVkpJ7Ts4QW/HlrI7+offmnPJ4TgDcLXxlzJcyb0xqyub3F4V6YhtptIIVMMEgeLdkEzQokOdbccTsFv40ZMpWIlK3JxfvoP4qd7a3VTiFoPQ9ZSaz4pWYzr03XxCWL63JB6b6evUR7XNJ+zMPBhtv+lhATPyZIpIuXI9v2/uV6Z59TMk/h/v2+8R1BpO9JGqz0g/b0TVpZU7c3k3kF2cJi9alcdun9P0ruTAT3aOq1Ku8z08PDpwRcURDxU7VnS2jKH2atvWuaynOmWTnWefHW7Zr6WuOGnGNarcyqibh2LFLoQidZ4mJTjhyO82Eso1gVEt1LEQd0b++lrKFY4NfaKMHSpfd9nnIpwo1k5JVQEfNtV1RyDjKCUI3BRHysNegqCG/2f68kms3VKNH1e/oJSdOlCfQFJVM0gCCx1676jSzJhznqBy6uVtCIMRPlwqPqZIL7Hq9zHXaJDDctdsluGP+2NFD4c7Gf2/w7aEcDsE8sbY6/eDaHieKJl2RjW6mnssRJnH2YpEy72/NrSZmeP/ef/nfvPb9Ip7Hiqs2qEo3ghK2a1HMsHUUhHqTXUANW84iVVfXGhS571bC3r4L1uuLMo+aTVbBP+wnTrXGFBLk2MbGO2vrOK6/1u3nADDNTd6VTeqXtzP8TNr1wFLAvGtl3eECDta18b2g0Av5+wPWexDb9HOAw6IBhtH+4fPN/df7Z7+PRy/+Xr/Wevnx5lL589/Vc7UsrMFaPFeiWb74WhSxiYnJ3evUEOho1WnQBgBg2KOPtuW9YGOWjTnAAm6WQv2G2F70dYwgZZQwjcoDpsPCZJnFCBBpUJi8nsr8OQSXgIoWSi5EKDT9BX/nFA+NsRAoat7Oh63ZSQPyP6dZkfsr6+X9C9SuwvpLrmYnYVypxvjHKYnyspqe6NEF6s7UC7N5cV26NW1/smrXYZA82dnP0x+epWOTsk6GkGnR5DO19XHMQKzDW/kbCtVMlGFFZO5gxq9vmFUUMDuYHrFB6A0KCBdvTa7gUXpKJiSeqS5limj0I8sq8LdpmC4IbG4m7g3UUfUjVC5xhkqnv5lJYlTuG7M0kX2wgyta6lKCJrcdWVBBk7LGaxsuOxVT1yxUxwBVsMxSg0pkdJeaqJNxDMobCud6KOnNFoFInAZ1aNSF5yqDzhH6WiCMk2aUKjL1tIoFpBAUs8O/eivpERel6PYzEHM7cKCSDNlWPH+K+zc2IUv+G0LJcjIiSpqDFg2ojGBm5gMqpYMSKTZUgCSad6TbNJlmfF+D6OxnqNAzUc/3dchoKSZ+ca91iKpDBj6svr55NcrJdN4p4bqDPhiMcVtA/5DLkUwmW+xFa/LiJfsRnFujyaaas061HyPNQMIBMecvOsCoipkblURdKMWCpyeXLuRsXouJjxgrDljN9EacqVVCQX/3zv0gKf6B33o9eVT84TWLAcKpYVDcmc3ZmcuR7LOrbw4bevnVMtNHWDA1dw+RqE5qbxcb+YGcZURbbCeFvYT3waVL0UCtEBXPvGWvCzU/19eHK/QodnJa5Hao6MTXemSNfhGNJFawIMcWySQikxmwQ7FPziCwGCbQFPui/WOjBYRG3sXhCHtKcXt3EXY76REgKBnODwe34JofW1K35huQEtLJevqDA898naLhSUfcrnVMyY42fRSuGjQY0kN9wul//GkgAHQXKmwDgTC23EEqt+jiktS8+rALcQj2rYTCpXecYVWNGGlyVhQjfKha2uKJVgETbliYk5aX5dLu9jMEFOvimBDMOIsBoPbky4OrBmn2cw1YTPGtnoconUnCYHEbKwaNFBn4OgJWrZ+IhQ3zkFu21A5zpp6SQj5J8Rs653YdpUAU+VoouY1o50P87cF64EY1uQFPZmiAVxigYzmtDWM7b3D3TtcA1pxiNSMHtlQUVE39NZiiSm2IodHSmQ6mztGLZVgqCLO3EVzGgJjr5ocKONkUJWstE+BAPwHr8OAHrvtkuoP754v+OaepTLaMDXhNF8HosmICrPoBIE6ycMHTw7eP6qu+ZWQMyXjoFpgfe9lLOSkbdv25kMD10n5m9QIAY69McSOy4OT7oqwXwo3+rgZdvxOdT96GG6piA0OH7b8PCYDfeYDXd/kB6z4dbH2WM23GM23Oaz4T4zGW27n43WS8Q6QbNAJ1KXnJ3fQE3hs/Ob51Eg7MhAXyyJbSiDTlCT/Q5FffvSqn5OGQKbfiq8YzGr98eXQSd2xTC5k5bimZWkVvyGGkZO3/0rLQrSPiugYZWSFmRCSypyOK1J8QCpiJKNPcQdJNt19ounPETd5ogAKHjy9aLg9xUeOncVhz5Hhus4U+6uYXM/R4pD+yoStzxIg5P6arO9M61aMeezOdMmmdTjCOcewULqmhUB5Gbihc6w5a1+NWiACcM5LXAqFdmaSpnNQILPclltWT1+K/nczRRt1YEvmGGqggu3Vizn2mo5LjoC9E6oyQk26mZS8pzoZjrln8KI8AxEJ73e28NH8Amr3exk5BKNiEaiyv6JV6FQ3GSJoXNLYuh13FVX0x9aBCwkKemElRpVYiEN2NCxzJhd++XbUx1iPLdymTXXQ/3PAjJaJGFkfQXb/wUogk2nDHugGlk7ycXt4RN2+fZ0Z4TeF6i35e1TLbCIQ/3ImwABRa4fQvK48+f0iKc7bxjW4jFiCKjnz002QDKrKCZuxHq0A9+3yOaxKfPvSxB6bMr82JR5cE8emzI/NmV+bMp8a1NmV7gcnkvcnP6rO5JffdnzrtPMpL9JBfmoVraPoe8FNdQBt6Ca5LIsoSnIHQmuUy4K11HKUydUfUWyDN0T/dz2SZ9Dtr5Ph9VzVjFFyw0W837j50jZk3TWIA/+Ez4F3Z994tronV6FlsJVXSyXBN1vmtBcSa2JYhB95Wrjj92AcPp8O4e+ZPKSHk2f7e9P29aNTRyn7T5r9l1bGyHQ240Qhy6PDiWYn18rrhOeI6cYCiJkwZyZrbXk6G0K4UpAMCDPFS1Dmkese6Xrp1mmwLi6NxW9ZppwE5MrUu4ZJVRLp0n1RjwYgvWoth1QYQ+Mlcl53pRUAbxhSIZ9qGLLjrZF0LlAOUZ+CIbOK+1KcKbFultgQCsu2UJ7u6Glw43LMJfOITu27zmWbjk8fLTYdzVe+/RWPH3BnrHJlO1T9jw/evXisJiwV9P9gxdH9OD50xeTycvDoxfTu8ozPwxFplewJ7ZYEcJxp4GiEK3CBwmVhpMJdyWEzYTK1qVc4PYX3KrtkyatYu3bWiBWVQMhKuHisVjV7esZFXkfOaANFfZtsBDFEyKCcbrdPQ/sK1TDCt6kHTDbp8jf1E3sgudMM402LDZLiKri3xg1emgQ1LgKNqVNaaD5QB3C1sKjdiNjSWgXYwXlnoSr8+TIlQ3QVauT525a0DIQkSw22usyUBMNJAFTdvhMQglmIZEXtaph+Zc9V/QSq/0NjqmRqUgUwiyhYESGNUumUrFRsgl+6YEtRr/BxAs2YVB3nQTIfACYH209Wuqw5ASEPkV1ABA+3R6MAe6ZNqE6GsygGSTVLO3UEk6y69IbxoWWjM4LmbPa4OLCbAgxoNgLVw5I5kqd+Ig93W7KiJ2RZg3X87Br8VDCkbb3BbZtjFe9u+ektqCSVNJ1LaEcXgTT3tIbWEIcvsOF2lQTGYynnh2yi1wh4NgtqqICw6s0GxAT/Hy7++5/nfQZnQRcPqgHFCN/cfzOWv+Y8kH3uifgxYRqLLWgHjogz7bkhHBDJ4K5X0kyyRu/QWdTHCRW84VYoTZ03RO6gvWGuuHjFlcdaiid/t7ajs0V+Nn+L5/L396QEGDW0i36uxJ5MNQsl9eE2isJi+YwQ6Qol13d4iZOGbj7QIOg7DBL65NjHFpLzYrf3KJl4VN3RyUmbdCpc8nstUXC9khJ+OEdgYep2+nereK/YHicC/R7DI97DI97DI+7JTwOz4nbprRNSw+HXyxGzveZfIyRe4yRe4yRe4yRe4yRe4yRWxkjh93G/mwxcg5qsskYOXe13xEbRksXUBVPrQxhY4PxYUmqFDGKggIkZl99vNxKdGS/Ex9fYbzc+kLdFwyaG6D5PzxoLhU1H4PmHoPmHoPmHoPmHoPmHoPmHoPmHoPmHoPmHoPm1gqag8JMiFfnzLmM39zizPkOfS+WTkqqNZ8u066utGTK/pnnEit+2HvXzUUM/SSFrLypJVShnjPyjhvFyPHl5X87+TuZKlox7BvuHm0F0kHdA6lgnW1A3OxYqjLUSeHKicxOh3Rjnp1ejMj777/7yXVb9s55Skguq8ryCAcvmv1xEZmhueF59i2A4QsFuSFzWpvGee2t4O6kJF/mIbSARnQ4DW6LVzXNzdZOexqWz4HUsm+94hJXH+oT+QnRZXLNBWgBIOjQfG75OOh5kyXx5icDXkRPfjDXCDYpz2VVl1xj0MxM0tLDx0QBVkNSMGFPqFVL0WW4tXMPN1rY1S/ASh2Gw5TBWT1tFBR3cVvCf0Nzp6eglgSIOw2/h90IIX7Map0QtgbbZe/GMJkbrd1UmXiB1wVDFBhBBL2CQ1V7PSLMSsdgA6CGcDGzyp/hFbZdVswoqWsUO8sEXDqb4QJ9SZTO8X93dvnxjTtfbc0FyXljV7ElaY66KaLTEyTQo8feP12tJl8KJ2UHYZHvqFH8E7nEccIOOtNuUostI098d3v9em+PGkPz66yyY0KVaIRE710e7+8f7e+FCXa6WMMHhvD1hUSCEKixPu4iulKW+uVxh1xtCHdQ04iJfHPlCBkJc5BGlX9SDN5rhIDjcG98iSMd2GIbr7jPw6c6rPfB8eqB0XuXB0evXt12ru3vK9C2wZPdirT9k6JutTCwAp9/zGlfG7utG39DB3597N5rjIBrRXNvvfKifPLValn+NEZu+0GGEwGooOXyN0ZqpkA5ExCepmQzm8vG62aUVDxXMqStJG1bQBi3Copg5IazRRa78wax021TAjhJZHiimF280WQ3egx8eb8Fm/jffXzSVElhdpkoOgGHu3Y5vzZMcaZJRYuwjqjhTWh+nb6p1++KY6HfIONdnXGCE0fl+xi/QXB1XJvT1NCkGisTuqBerC5NjJwxKySD5TcMGc0+aATwCJ9TUZS4eUk0r2Fq17ncWILJnk30aDJ9dTh9+uzFi8nTo4I+p09z9urwVbHP9tnRi6fPu+gNtRT/GCSH6Tuo9t97g7r32oRAA9ALKkZ1o5zfDTTNkC1jdeEwJLa0cvgFBdrVbuihb39/uv/8BaX7E/pq/3DyIuEKjSpTjvDjx7d3cIMfP771OqaP19ZNDY5I7MVgpzRgboBcHlraVzTWa3VPhmKsc0YmilEs7CsXwpKEJDqfM6vJeNdVTc3cvS/JfdpPbdY8euqiJZ05RZWxY9bWYrHIXJRwlsutdvIA1JTG8H0K+KzoEi8nV2Ly7Nyuds+i0OIVba/lMjaMo12XCrpgIDEB2pNr54NJggowvHkmvet07MIqXWRmj2jaS2jhFXC4weYp4MByHc19izJg19wyJz955PBS8RkXtPSnIaClUWUnNL0zBNcY+AwVnaf2QsMExBH0UJPGskK1BHlhDuet/X5n8JJRsGbVTHFZkKrRBgaZMNdkkRUDfjL0c8HDE0a2ajHbiklo9vWtzH7X36Ha3YCJ6WRWRe/+w5dMl8okEegWKXRqXEvh8V/GCf0bWW91kDP+yxiTxdo+RA90x1S7wTaYZ1N0w1i2BHYyXtlj5mxlUI3VytPhEC2TGGVsLxvWxQUZWxqz441HZDGHGxEPocvk0lCgWGijGrjk7KHGcrNeCGkHaKehBAMiX/tUvj46erqHaQj/8etfW2kJfzGybmHUH5LNXYiVLCBNLZ5HIBEdipiH1fZDm5IcThFCnyspuJGKixmeFFcnvAhMc8LskXSbOcJkKKrT7aE5lLUv5cwF3NlX7amHBkS/NBAz4TYEq1dTuG+6zuiwm8GoGl4Lw1KQiBdUB0BHrftwMBP5szbWjrbi59ae11TrZCcfvskVDt+RvjtNRzbcq6w9d8KDHIK2OuBsICQrDQXqwXF09LTffePoaQsoKBi/ycsUJnBEHIJLAV78Bdc2uIZU3tzqEFuPx/8H8Hj2CS+7eEOns0ACIAo+4XYX0r4LJzQxYGAL+gR2nyOP7ekpzDdpTHhqlEyGi8XrPIyIESOCsKo2ER4AHZ8cu7c72WutdFMyYWbBmGgZBcxCokzXucj+6DAwy4IfY8C+nhgwVG42RQQXMPpqngi3zVbn3kXr2Pj1oHyG8K64t9p692N0G3mMbvus6LYNB16ldSsSGSWFoGUE0Xe3Prn0oXHddNV+580QRYfiLfa/vaFB5nf6eDuF9bukLSe9wZh/Bhk/aZiJ/YYz7W5UH55DKok9IdCUyguvTnqDTegA5ARuuK11Yket7uGw/7cNTPwjYxL/ROGI/+6RiH+CIMQ/Ov7wMfTwztDDry7q8GsNOLRPXdGZN4klVzKJ365xMeMY/nqOxeNkxXyTat+JMYgEDrjLOVv6DtVzuSCWwQhwH3qvJdQcyWUF7fW8jltTZbXFJoDq9ct73KUsVI/6AifZzdbdEn4+91UVvkBL3hSgiLoeUBd0ShVvAbVhg+aPwm3oTbvwSiSugUT633hZ0r1n2T55gmj8H+Tk/EeHUvLhghwcXh2gNP+O5vaLf+yQ47ou2U9s8ndu9p7vP8sOsoNngZ08+fsPl+/ejvCd71l+LXeIKwWzd3CY7ZN3csJLtnfw7M3B0UuHp73n+0dZu++t1NmUVrzclJnpwwXB8ckTrwQoVsypGZGCTTgVIzJVjE10MSILLgq50Ds9BOKTPbg35wv4UDNFk8hKLwyBSAwq05xFAlCQlLyiiAJu5zv5C71h3RVcMyXYF1sDzhbARj8xXXh21IX8KDvK9ncPDg53oa8ez7vQb7J+yDD+vZ8zwf4qhP+jC60Xkb4UxH4+R/c5E0bqEWkmjTDNbbRO1YL3aH2wgNTmgF+XRg72s4MuR9ksqP/V57orrgbLBb/ZtZO8JhNMTaAin0uFH3cxTP+bIEv8DZ9pzfY/YdATb452kf0TiA53AfVeOQLhsnRdJWGBoLsNloQCeOdSm+QIDaGkBcsP7nm/dLfq1sgTCP7nFfstFkDCgWnJgwespmb+2hkWOg9XfKYozmdUw9qj41paw8rJLyz3Qi5+uLpzJf8z3GIBs7CPIE7PGgXodIW2BtbXQ1p/baEQ6zrLgkEHd6M/8ODW3To6FNSCiLHMlw1cd8cvORbA5NDcGd+1GoMj6ryUTRHp98R+9LYcqHtHXYnpAeS/c7+imJq3XtWEFoWPe4R6YlfwwJUf0vfWliql8Naq4YWsVtJSRNSSY4wC/rL76Xb6SKVA94o9Z654FKwYz/3A5LyiMzYwNa34Lp3kxcHh00EOE2c/syOQs9OgeiOe/FY42vwLObZkgsWMoShwOCWhyAYzNAsoASTfQWeDD99KZ8kcHsBYvPv2acKCwvP3nmmNo9OZa93zk8xW0XzOBbtKilvePpl7IUteWHcux9d5yc3yag1uevtb687qaHzdjeudr3Xnwco4a83RenRwfM+PCplfA606hnTqPw8cL/wNCpl2y1O63+y51nOpzBVeC6/JlJYQce1vcZxvNzCjFbdtAIsMaNvtV1pMxBe7jdgdRlaCsOFXBpG2YirLce4/G3C65EDdc9bOm+tN+vnTOS8q+Qu5/HD6wQo2C2IkqWhtmaxm/9GDpSVlkNslDbKan5PA0xGEzFOuvc8j3f6AnwYGORNTmVKruxagSLLnNQmB2u8HydPdG29OLtK8TB6qi7JcZ8uqzNxzmL9KXWqokGI3vtmxuMoQ5bia0ldvTcss6oeYSFkyKtZE7zRiBKzvcdv780qdTRpe9qfs72i4vbcOXp4e7L/aWg+cDxcEZkiNs8OAWA1+8BzcBos2ipl8vj4wfhb0q4hloMDrZgLVlqC0m6PDv6ffDYwbfw/CXltyi4OSlApv56rxpTs5awvo22mui/FaFsNs516HOcFALQs0Rw5O1Qzw8M+d6VwW5Mez0/5E9r+6pvnDLSqO2J+sU7//ASbzNqz+ZI5dfvu7GXPy81VF65qLmXt269s1T1ECsbtIKlr3QQbnC5z3rw/uBLZh4BWDkseamYfd4jjuio0uWF3KJcRdP+jEcdwVE0NF+2lTPviSk4FXTH2HHPS5E7ebJdxf6Pv98+K47oJxvDzeLufhi4Fx3Y/xXglK7dA9EMcm97oE2Kd1xU43Q8Y+sbwxdFLeJnq6Ff8iS3nN6S5tjCy4zuVNqpz8P/grOXW/LEn6HEk07zutJwNDpbewgyMMucoq6J7L0MTUtqLew6TmDaSumricBgASM+nwnPw28+yK6d7QfO7cmpgJE5zNrqyIC2VjHFIgQvmKooHIYujR1dQtmybBWNIKy+8GoyA41muqaMWg6ZIiE2aHgH1zlUkw4gm+sB8xJI8XAJpmN9BtrKbKaIxtPDsfedMSkDsvRhBMAO6cFkhUFIQb7RpODKHQJdnVShZNbu6PyEtX6xrPrhvGiolhbbdN+9nk0pp2WwfL/5Nk5p07phaFVJ83M76blvrG5Se0oJMmMsNw+FzFe8/+48e3ZG6VT+iCAdM5agVIbkN63qiOM6OtJq2Y9aeQCeTXh+05kMSdSkkbM2fCuAIwPkPEszW9rKgwLHcuDcfOOt+m069gN1CIvGMXSbIbFPu14YoVjqfeuqrzt2+OL96QH89Pjy/fkNMPJz++e/P+8vjy7MP7b/7/AAAA//9MGcah"
+	return "eJzsff1z3Day4O/5K3Daqhsrb0RpZFlxdLVVp5WcRPVsWWspl7f78kqDITEzWJEEA4AaT67uf7/qRgMEP/Rhr8bx3qneq43FIRuNRqPRX+j+E/vl+MP52fmP/42dKlYqy0QmLbNLadhc5oJlUovU5usxk5atuGELUQrNrcjYbM3sUrA3J5es0uofIrXjb/7EZtyIjKkSn98KbaQq2STZT/aSb/7ELnLBjWC30kjLltZW5mh3dyHtsp4lqSp2Rc6NlemuSA2zipl6sRDGsnTJy4XARwB2LkWemeSbb3bYjVgfMZGabxiz0ubiCMb9hrFMmFTLykpV4iP2A33D6OujbxjbYSUvxBEb/U8rC2EsL6rRN4wxlotbkR+xVGmBf2vxWy21yI6Y1bV7ZNeVOGIZt+7P1nijU27FLsBkq6UokUziVpSWKS0XsgTyJd/gd4xdAa2lwZey8J34aDVPgcxzrYoGwpjZdSVTnudrpkWlhRGlleUCByKIzXCDC2ZUrVMRxj+bR/i539iSG1Yqj23OAnnGjjVueV4LJk2ETKWqOoeJEVgabC61sfh9NAqgpUUq5G2DVSUrkcuywesD0dytF5srzXieOwgmceskPvKigkUf7e9NDnf2Xu3sv7zae3209+ro5UHy+tXLv4+iZc75TORmcIHdaqoZcDG+4P557Z7fiPVK6WxgoU9qY1UBXLjraFJxqU2Ywwkv2UywGraEVYxnGSuE5UyWc6ULDkCAp2lO7HKp6jzDbZiq0nJZslIYWDqHDrIvwD3Oc4bjGca1YMYqIBQ3HtOAwBtPoGmm0huhp4yXGZvevDZTIkeHkvQdr6pcpojgEZsrtTPjmn4S5e0RbPisTuHniL6FMIYvxD0EtuKjHaDiD0qzXC2IDsgoBIsWn6jhNgm8ST+PmaqsLOTvge2ATW6lWMGWkCXjCBceCB2IAsMZq+vU1kC2XC0MW0m7VLVlvGy4voXDmCm7FJqkB0vdyqaqTLkVZcT4VgGvFoyzZV3wckcLnvFZLpipi4LrNVPRhgs4nc1ZUedWVnmYu2HiozQWtpxYNwMWM1mKjMnSKqbK8HZ3R/wk8lyxX5TOs2iJLF/ctwFiRpeLUmlxzWfqVhyxyd7+QX/l3kpjYT70nQmcbvmCCZ4u/SxbqI3+c6vhn60x2xLl7f7Wf8VblS9E6TiFpPpxeLDQqq6O2P4AH10thfsyrBLtIpKtnPEZLDL8adTcrmDzgPy0cL7NaSl4uQaac8tSlecitWbMMmHdP5RmamaEvhXGs6sCNlsqWCmlmeU3wrBCcFNrUcC+JrDhte7mNEyWaV5ngv1FcBADOFfDCr5mPDeK6bqEA5XG1SbBAw0nmnxLUyWQZgkyciYacYycDfhzmRvPe/gtwC1hn4AQWgrELZqf3++rpdCx8F7yqhLAgTDZpYinigoCEKAkbpwrZUtlYc39ZI/YmRsuBUVAzd2kYcvAVjXjBr8EWIGRIjITnNjI7d/ji3eokkgzMCFacV5VuzAVmYqENbwRC99MCb8+KHVRz2ByDgc7h7HheGV2qVW9WLLfalEDwczaWFEYlssbwf6dz2/4mH0QmTTIAZVWqTBGlguC7F83dbpk3LC3amEsN0t4+fjiHbsEdtJEMrcRkcnx70ZbaXaHqJaiEJrn19JLHdrP4qMVZdbIot6uvnNfd/fSGz8GkxlskbkU2rGPNETIF3KOEgjFlNkOfO11GjjJdIHagVfgeKqVgcPfWK5hP81qy6YILpHZFNcDzj8iRiQ0XvOD+au9vXmLEN3pB3H2T03951L+VovPmTcx+RGyqGNspNcKz/WZYMjGMrtzellrevC/m5ggaS0AviUReitoGMezncShO4IW8hZ0WgVnpVs59zadUEuRV/M6h00Em5pmGADblWI/0IZmsjSWlympMR15ZGBgFErAJHScsuY4FRXXnFQQmr5hpRAZyKaSrZYyXfaHCjs7VQUMBup1NO+zOSi+XvLgVJ1I8o/U3IqS5WJumSgqu+4v5Vyp1ioCJ25iFa/W1T3LR89wAGYsXxvG8xX8J9AWVEGz9KyJc/XaOMLD09wLXQZy28vsQNXmXcfiNMRMNK/gESbnrYUPMHsM0Fr8gqdLMAn6JI7heDqTsbkBUv8vMmPbxO7gdJjsJXs7Ot2P1RjT0mFqq0pVqNqwSzwSHtBnjkvGm0/cKcJeHF9uAx9yr50QYqkqS4EG41lphS6FZRdaWZWqnDB9cXaxzbSq0VystJjLj8KwusyEO8hBydYqh/UF6aY0K5QWrBR2pfQNUxXY/UqDwkMQZ2LJ8zl8wBmcd7lgPCtkKY2FnXnrlSs46DJVgD2DgoTMVjeJolDlmKW54DpfE+BMzFHJDdiqXKZrkDmAqKQJJo8+MMu6mAnd5ozBozJX5WKIA+hIcHDADlWg9mceo94ykb4RHhNMrwsQQrCY59usRuD5ujlxjFOeA+mBbiIsbI/1Jq8mh9+3Jqz0gpfydxSPSf8YeTI14X00Dg7dw+1HpRa5YG/fnkT7Is1lR78/yeUjFPxj+hI2gOcRUDmRKaSVwJ+OHT3paFsAenPlOYAUdy0WXGfAXwb0NVWacfS+U+Zm0nnApCp5zua5WjEtUrB1grSFs/7q5IKgutOiQbOHGzyA1yPMcFMYUQY1Ht65/Ns5q3h6I+wLs52gRuEs0Iq2dW8o5+kBdas1KMFUGt1YApwFXkP2VLKal4bjLBN2qQpBfIoGHb5phS7YFpnGVuktj6liWsyFbqFSdiZo3Hagn8k2c3w0E8E2QdvMg116FBigVS78MjdDxPgj6RN20hoATpTa1KB/EtTGKJIloPePukT8nI0EpkIw8IeANfQtle2BBGXHrdcO7jLih8AmBG/XjxO8d7h5nPoEDiIjCl5amQKC4C8BEvOSiY9Ohx47xYaAShP0LavArVrzXP4uvDMRPE0sFRqNYCNtzWk5zuZsrWodxpjznDxjjHkpDRJuofR6DK96RcFYCU640tRoFPLgMgRlIhPGAnsASYFgc5nnQcjwqtKq0pJbka8/wdjhWaaFMRsSYCPkdlwqz1s0IOkkQcwUM7moVW3yteNm/IZAMrYCshhVCHB1gmVo0Jd0djFm3J994MEEYf+RGXDG2YSxvzWUJdXJ2EZjYbiOmq88Tp7vpwk9mDr+DEwGppcowTAmqLC/aufLcz7IaSKrKUi2aeLQmoJ3oxJlRqo3shfYdQEkmtnJqL0qJvn/7lDlJvlKz9UGx9naCvOAChyth/OEtD9rIfIXgOe8ICEQQfuElsmJsz75Xh+0EHPM9gBmn0MqkqsOftIacyFUkkq7vu6v1NMMLe16eHXegS4teN5HR0G4RpR2UzidR0Z9GKyH37nSdsmOC6FlygeQrEur19fSqOtUZZtA88QNwc4u3zMYoofhyfGdaG1qNQmlwQU94SXP+pTKVRq7IO5CZyHUdaVkaYfGfavKhbTg/4UzNOcW/+hhMPrfbCtX5dYR2/nuZXI4OXj9cm/MtnJut47Ywavk1d6r7yev2f9py2lAcoNyavSzEXrHn5HRT04L9+QZM/IVIIHgt4XmZZ1zLa1XzpiPc2jh3PTRoXbiz7LgiXEcLrVz56QCTCNSiOe5UpoOA3DrO9edVze9lGOEXs6q5dpAEDNEAlK/rRsdn7FzZaNoJ3hG4DCGM6rAQ2shlJ9tMuqu3UwZq8qdLO2tjRYLqcpN7rQPOMJ9G23nryd34bWhrUY4De60v9ZiJtqEktUDOMhqaJTR2UVQnLxExMMi5izntPQODx+CO7u4PQAl6ezi9tDDED7q7NEqePoAXp9Dm3fHJ3dhHQ9egiO5esS2voM2V5qXxlkuZxcwEOnxLn/j/PgqGMXshUgWCXldeE7YEFCMd3qHTCsEEPZKZAcyqzm66coFyxXP2Izn4P7TZszmUosVmCFod4PnR+guxWHSldL2EdMeUHKM1U1Q5k5qAPx/FXo4e9O0yXGfvtea9YX7+rO0u/02Hr01eYzSefd6XNAa3MX8IJ2MFVpk10N65SBDfM5eHIGht5SLJSQhNYN6GrmxxziRqoKww9wRrZ55dZSguqAlkc8dUxE4sg/Bg7A1VypZoM8MEqK2wIW0Ff0dc1STiUOhF4hS6wI9p5UWqTQiXzvfBncWKQYsYfCqnuUyZaaez+XHABHfeQFpWUe7u+4V9wbYPdsJu9Jr4FRwSIAx/1HC0eeO19maGVlU4HviN82q4qHOIKkL/f8u5cQZyxBvRUNsJfIc53719rQJkm6lKqlvtpJRl/UaYrRYwqrqGnnvC3CEmM9BoN0KZlXlmI54gb0QV29Pt8cucH9TqlXpPVcttBiRfuxdhEiiijdsT/CA35M+83THDWCBjg2FAPrWvzbbIMvcxTHNQjyOd/B5i21qIzQ5QjbFMbFF5pzJSjsXLQwOS8RZIdAHouZ3SQxesrenxxdwFBy7GZ8GUDGrtM8HGCARBZf5hiYH6j/DAbzO0hbUiMC8zvMBc/dJkRgZBsMgEVDp57dc5hAo7p1dx/lMaMveQOxRyLKPL/oj/zCmwNE3zxU4TLKx/JF+DsWc8oVwYO99c5673SrnFrSCAebB1zdpwsYr4QbrI7HkZrmh4UdEKZgs5N0uQaFOldYClPNWshJQkJPQKBkvVbmOUx+dYhWxys9GUCLGFD7CBBtw/OIfQNFpSJBLVTl30Ueet8YEl0TKyybgwXxC6xBTbSQf533HNqu7rBXsJJxYH6s+8zwJXpdL0FIBOKCXq4Us+4hEcoej3GlFQVWdtYOg/sHdMVCXx84cewRfeZqrGjPyZDnXPCS3Nml7Lpjhcl4IMdD4k3vS9ObsnbBappCwAfIoSs/hkN6/7zIGgUPmwqZLYdAZE0Fn0hrKjGyQBI72fGf6mZkSEh9d2kcbBYKr65JSLrUolA1JIkzV1shMROToYuZw4oxyAv2ECDCFVvBTciS1c4/xlwiQXTaDe1NJppAW36BKBPuUcFeagh9yc5J5dNUQyI0FfBMHNiBzzyfy0i5bs0zO50LHhi78YCGsAn4w5zrZsaLkpWWivJValUXb19Lw1vEvl2FwmY19MOMEsXr/4Ud2lqEXwAW8exs+GXX31uHh4Xfffff69evvv+/EbJwaIHMIA/zeRLWemqrH0TgMxgHvoAuloaILuyDaRD3hUJsdwY3dmXQ8X5QftTl2OKMR2Nmpl16IK3F2D1G5M9l/efDq8LvX3+/xWZqJ+d4wxhs8sgPOcQZjH2uPkn/YT8R7MozeeTmwru5BKCKj3U8Kkcm6bcRWWt3KTOgNYRmrOk6a+QETn7oaXyvhKzNm/PdaizFbpNWYQDLYmZlcSMtzlQpe9ibHV6Y1LXB1qHJDkyJf8mdut/g4doJe6NaR3Hp4T2pSeJGkOp63KEVBbevd+okuIlQilXPpXckBC5ddQe4BckaqeQwkiNarpTB0XLl8kEiBxPPKOXUDaEMnYbmGMwoyFj7hgJLZBnQpUoKbycusvYdlwRcblSnx3sDBQgTVIQRXG2a1zC0c5wOoWb7YEGYNZxFefNFGILrXdv/o0f22e264dYY/w0Hpslhr3A2uRjPnJkbkhyWW3dDIHxx0VvCSL0B7w+M78EFPkmSQyqMjMRIlQcWC5LTz+B5REr16f7IcsmicdIVBVxcU2G3fLxuAGeXHPZQZ56QPZcZ9jalbMREel79FECkZ9MnytwJYzON6zt96zt/6+vK34s1iVetO+B+VxBWLp+dMrudMrudMrudMrudMrudMrrszuaJD7F8tnauF+oZyumQFo0UjPZTIJLxEwwymSstbCD+dvvv79lAOE+4atA2+qjQuzBuK/CU0U/AE2YY2VsE11/PjK3YqIBCQPP0MN5GY9Qlq25fLzrqTl//oFK2YWs95Ws95Ws95Ws95Ws95Ws95Ws95Ws95Ws95Ws95Wp+Qp5WVrTIup+eXD0VwfmhFbeBQPT2/hPJhGvJlwDnES7MSUaVI+J0StcjzL6RdxmUCmhorHtaaVVrCblVsIayrkuDAEtAX06w0CVIO359uU9G2tQ8vxNBRLvsyA46hiOtsCJcgmCYIZVwqNofaQrknKuHg4tcroYXPMshItkiDpFj3sXSfTrc/JcbUmvF9u/6zop8jKMKjNV97Yjgq0/c4IbxV7jBnhip6aGFrXUZbfrZuXacJz68wiUyWIBINFVQIkR+/Nm4JoPQSjtoObM3WUA7QczHUTcXyJA7Wkt8KV8YnFhZFMx33ox8cokfcAjwC3/WbwTID+6GvzhnZrkoWMkA7OAnv0eok7NgyKAxU1MWYHga4flJFbZq6pyAmpjDKFCiDBSt605Cm0R7GrODeKcKAMQu4CwD5EtZXDeaGVcoYiW8De/MM9uEaNCLpC7wgh3nz+g5EuWGpq6DWioh2ODJJc76x2CewDcKHTRcWhIgH7h/gGEg3E+QJcUVrerLu7HwQ9SiP86kxR3sd4NPjGWwoILZHtbs5BHdJlN776z6FghXGayeAjRNYniQxQCrYk4y6k5/sJf7/B6mwQWXGUaHR/IDjovSlDuqsciVc4t14BqH+dAkA1JydnB+/ewPK6EwAseD7/FZk41g4jUaGTWGwaSRiGtHOoOYHVX4BtcZUCkiM9mWzGRAILN80YWdBVsHNHLIPuzB9Md0plh7yYdcpnGsCPJX9ZVmtVpFnZXBlrH2MoXSXew1oD2F+d0/zFjUpkNw4XyTA4CKA1JyBMZ4uw0CgZc1RLsVyO5Mm5ToTWcL+LrTyOXWF4FRaJ2Q9R/SbNURzQ/Q26+T1MJ9uMK/xyu8uNf9cEYOs2cJ7KXgm9PU898WInx7v0TGe2WrO9lkurBUapaQbmeHI0V5687FypfNoobiGa0LHY3Z1MmYfTsfsw/GYHZ+O2cnpmJ2+77Es/bnDPpw2/2xHPTdmwMEKwdScxzk25LgxckEaAjBcpdVCc/Alc9uU8SeYzgGIaplL04gAYf5TJZvMDiccTN9kP9yfTCateatqIBr25JN3tQlBtYHBSI1yeZXQNWAp2I0sMzgYcIakUBFEFkpoxz43rP1rPe2awmcAhBMYPHIcZbAcdwzzThr99ec3H/7WolGQjF9MY1Bz2q3+wID5SPGgftCS4RtCFI9GGK6LGr0cOhbgO5368KUqdyotSws6IbSNwCYK2rAXMwG1+17ugwWEGLDJ/uF2k9Nsl8q0vmjEeTCSXI19YVIOl71n3Ag22cNTZAEGz4tfT09Ptz0NGfsLT2+YyblZktH3W62siCETqIRd8RkUH+RaS8i2dOYDZF9DRRgZ5XLNhchiCKkqb4WmqNavdsx+1e6rX0s4wECuydum4NrjjtmwzH94EOc5cPPVBG4CUwTib5IZwiBMtpwLNMGmam2PRfuCggBBU5PgnEIORlkYRho3pDH1bD8x9WySEFWAGluxsIgxdDKI9iSJogjG1tjF9koF5T5kDitcCS3VsO47TPTnsNlz2OwzwmYN/3wZG4FMpfuViuPj47Zy7M3V638m+eW456XLc3Z2AWocVMQs2dTbS2B5TVssI8KPU+/tI96R87lM6xydSLURYzYTKYeiuMTHt1xLARWu5/HlV59FYcD9BGxIaME1K+zr1ODn43GiQdS6jhuKofs2Is40gC+wy4i0waMFr8syEx8BqwK4JAbtVAL3Ef4uuAETwaoAsakdC6/C0q1hEj0moz93et6T9rO2FeCV4S9hC/ixhnPkzt+/+fDh/YcWdhvcG6N4cwQfP0t5hb2HxkRo0EmROSOu9CV6ybqOvwfHV75Gv6uBl+LoQqtaL76WauG7lMH/Z2XTuWbucOuGCR6LRYMA7R4fEWgh0RkfvEw4Pni1aP4vFNILE6+4YUapcK6QweZ2x3bCjsFxS96aAJOo2t77d8cqvEtfzYMPpSdLg+/Xc4lIW1GgNycPRYHeCct3Yn+1v+lHDunk0TGOhzobDLSn+6eYNm7dh66wQF+YDLThS9hUpCahl6aopAU0CCbNxYke8O1jvxSQxFEft4bTfoGLL7hmuICuUUzQ12SZSYg17OyQn5RiGIAQ0NPkcrG0+dA99Wg2+D01NwTUcsiyQ/tN4xIZxrN/AKrk6DDpUhTcfx0gkuynKfRYZwKdIGLO0VrpFu+EB/fEEFuXOuEMaWJ1Ar5H5RXCF+DaCDv2Z4P+1wJkt3+PIkHQtgmIlwtXFQHI7AWBhmWB7h6m6ffkpwWvQCUHkc/9FgNz1kFPRo/m4r7sf5Lw7htAA4V9N6LgELzXDfckGNydQzGAAfmaHkAjNMobnKz3V7UAG8vTm2vQLjrAn/SExVEYjhJCMjhLYKAqB8sHcE++1AEbn6+B4uO48xDddgfPV1wuQHxMRdWkrUbb9x/8lic5LxfJeZ3nF3DZQ+g3/vV4X4cK8H5fhwf372vaU0MXxX1B/uG74rnyJgRyAZRGae3PIAaOoZlap0sGLxs57s9JfzqCHQzF65fwMJIXjfb+tunPiIFa37PO+mAKtyGCBU8BUIDhqwvgQM0kCJ4HxX3rNOhXpDGfIeqt1/T0IFe3MzJCjjTB9GFpOBR4nAWM2dqDjUFmwq5A9ea+riMnHSPqgucGo54akEGsoYYKpNewY78SD5MbdB+S/Iy669SuBneOEF3HBchAb3UQxCN0mNDRawS26cHXonrMLQ3JC1FAkiMcLDCaB5dFTQ0JrNLsts6hAQYWOZHCdF42UCZKZPjRJ5wKYAzdo9p8vmQYgWhw0IO+7d357bvR5DSg04MCBvEGpHwE6jJyhlUZcPUajW7JSzZ1L/i+GdOkV/kG9/oUhcMOz7LpmE2J5XeQ5QU+gn6HO05rzqYuGuNjEgFi6KznOY5mBt4G5IahKjmQZbVTcWNAzO64RJ/WYnjUN7Ecb8jycSN0iU+bxKAXmBqoDMtAeDNYL51VCTBxdTDG1VkcxxDTsV9TI0pDAaPmThgPaAa8GsheI3WQTMJ+4Rr8DVBKh81r4LNG3VRzSD0Zs5VgVQ7RX+WToVjjK8ipiypP4YzByAUFIkO+FLWgrVz7bPA/oAMr5fXwNTVcaSxh0IiGu/WwJzuNR2ekA6VRNC5MghpYt7pGRnwQXef3mUUwUS9EM9iYUUEqUoKgg1l0t38M4QOus7ypO8CAtvQ2g3O9hn8oDc42vDTvdH6gk2EKWvOAmAVL09MzaDoRhwHz/CLLTK2MO/fZ2Wl/HQ4OD163ie+2dZv+vQ0W2op36UsSxgHpVVEb7jkOBwK24SaIYC/Cna11aOCIR+NsDUa77jfiph2KLAiSL5NwpqZ0M6lpnR4aB0WPmj1FuAaY4Tgb6HQekka6cvqsZAVUVWpaGY0pMw6CH2FYCoDMxIBZ6OSp/zPoysx7/Hzhs5TnaY2pvYBpJnLM/nCKQuwRQSHDKfmSerQHmK1ze7X0n/oexdAaneQ/hJA7jTQ9JoUqZdPGi0UgIBlHNSsGf/oSZFaxGyEqVlcu+IAfxZurTVUw/YCSXTrCeeV2XMrzcbyy5NwhPJNRi8vBFWqEfYDL//mEfDdMPJV5WAW/QOixxxAsHgp4GKioVBcoyopo469EgiSO5EeuFmNn7oJavT2OB4cd4VfKqQNrUs8U1QX0AqwQDcRu11ELawfO86JAbyi2PIUAnvepIHhQEVpjg0BvMrQKldVRp1X4ESyhPFcrCFfDuZYpV4ux7IHpyy5eQRpSEtEiLG/darz6CZcKO1/Ksqrttf+x5KWiNCz6XdU2foGbdzLP5eA7LrSDUnIyyDinNHRLbwCZFQ3b5iR8I0HFDDVw97cA40ALin7ZJtzU7Ag7LGG8+ICfEQr0X0XoUQkuT2NRZm3yDh7Sdx0UDaq9M6J7PDh+U7p5DprNbXydH04QvBtIrcGzpIXqBm9d/AQXLV5UQi95ZWDzucbZc1kuhMZEj21YT2iR5s4nuNsL/WNyEQc3MlGoEpuSog1NLj9p10mX6ZvihkP/Ov7LyekX8yedncKm91ZJs2LJo3pHg1ewjduTLcroKkqoitBq6wsuONDX4Veka3er2UUs6Xm2CbiDjFN1sPkjR/o9JkHH7MKn0wbm1FhuxXTMpjznuph+nZo8Itla2ZaY39jZ6kaJcq7va5mN2gXpKfCGU3BMXcHVc2rcqUqwbmCJHGinuuT1AhVY5RWhAJYOZKAmtRynA90d0cd4OsFuNttjb905yCG/mfgogAxZY16fd+/3ie6OvhbVvU66Cbp/4Cv0mgYrRc2xhIkOrPwzaRj3CLL27gvaOigRGBgGrxT06FTpNfEk7IpMGhCWGRrQ4MFRsMmYEVxDDnKzW0AhoWD2DLJurJbi1ivt02u3NtM+KS9FxSbfs73XR/uHR5M9dA+xkzc/HO399z9N9g/+x6VIa6gd4/5idgm2jbNctXs2SejVyR79IyC1guiPqVFDgetLa2asghv+/gP3X6PTP0/2ICqTTFhm7J/3k0myn+ybyv55sv+yXS1B1RZ0tfY6P63spCHa4qq1ocJj+noGy1WSz2EcSZLIc2K7kEPNbEYfxh5BhwKJRiLhFDlkOucyr7UYFIgB4qME4+MFYoD7eMFY9xVTGlhvavEuQxR8aN2cGwALjTi55xN2LteGrIy+1wD86o2VDE6IRj2mRLMwqDdt/Gb1umYj0+DtuV1x35yXrNMwd9YwHJk6l2uDDdihmkS2jZ4AGAnS26gsHwGmHGvolt00r4f/e3EDBfjyMXsnIWir5naHprjjN/fOcZ1JsJG3++vovm4to5bm5tpEsvUuaTvPFbdDK/VBmhuGEGBCeE0SrGI1783fEIrMqBw5zUQZvBBNxbPNkWJkGtcEsjGDWGlyB+7X4KNtT2CQE++cxOgcdCPo55wx/fCExsEPjx4rgsjYHmzJyd5exKno0AEnNpeQIVKHC8iQtgE6SdtUJkZAjnK3CkyEUKSmgfgAECsoAg8WqwAhUDbTcFTjwCcurZP6jCejFhENNCov087yP3TJ5+HKNaNLAuxrTd6xk0FIm86rmOLg8PcuBTSqTc9tOQaCQ7JV65KB+MhTy5TOhKb7bKThRP5L8l7mUbGoxuMSLNwesW6Fbsy1u/bKpxEKKECxqTBAi1og0sl/atW97qVfwo0nMoltA5EOA7wZRQLNG842uNu8N5gHi4bh+QBOK5OQ86SuvDUQhUDCQhjwytOokk69VJUGEs1D5Ix5xqSFCf5IA/K1Ny8gEUn2MB+4ZVAuIL7GprlaJAZ/T/zvCbixp4lXV/3jJq8P9qSgzdPke8Cs/LttujfL0VKOfYmqZmeenV5uJ23Ngr7IlDCYe0pcDaFkBom9fkSXzAX5Nk2WVoCbqsoFnu6eLuDZnXD/GPiuzdPgFmkz9Gf4P5zr5kEPCIXeYh8IwWTBF9J40e9wgsA+3WB/iVGk1TeOpqZsc3tKsCEawQErTDAZrrSP/nqc29kGOYQH1sRJmZhzSH8lRg9A41PSbUDPHK5px0qaeK8cN/pfGNSnyOJtOwi5larE0PfZKQ2+9abWqhK7xwVk+Ge82Iou7PDZTItbF433r19ebWGJA16yn346KopG5Eie+7d29l4d7e1teVXk7iSVngj9rIX7IBy7gNT1XoUarLyIPBdO6eW3CluvhLLjqBvjhxDQA+bliLXHGSLFcQLKD/7ve/JPjvGrbrICXnbrOWQwDwTuiImyE7mifAq4U4GBPJ8FALCp2rOfHiAV7s6TkOfGqNStHbo/0CpEGWHGIUXD/83LbFfpZrLBN4ALOqarW5VWWZ06RzcOeeZtY/au8Uz85w9n7/6L3gU5500Fat5jthP3MRlX3pIJWaQhDM0xNx+WVea9+RDQRsSEdJ1PyowA+0Zkbab8JDE4egteW9hxiDMsDgoyDzpiwXPopYQaHkgIUM2apTQulgRxuhtvzRknYZLRw+HNT0MZyY/MBj5DHOOxWDY119vfd3B8ZPeATyEqt1bLWQ23+6jbB+xVuE5YLu4gs/vN+FMc5uEdmS58WVeAAZsWMNSUYoOg3IACM03xaYDrA54ulg2+HlIbQHWBV8dQPSYN4GD/87LB2+t2gEaHXhkWyXwEwT5HPLoKnHf0OgkIdZUF0yngHCpzbQrLUK0rZMcGKUpV73s47i5VIXZ57mnncUWk+uncT4Yr7p8wSA+tqly00FnIbEOIXGhZcL2mQmJwqP94drp977qOJnt7kzb3NTJy0xjGXpRB7PprCZGvpMhebQi/d6ev4PxdtjVNfGKWfLKhUS9/Op7cM+z+q8PNDbz/6vCeoV9N9jc39KvJ/sDQstxcttQZwG7S+n3aOvCeT09rTrf+Xtl/dfjy9cv2bik2h+07lbW2B6CoUsvzZgZRGeAY0b3Dg70Omv/kETxwAoejE275qgzy8DsW2gZrFsSBM6INWFjhIoKXxuMQyGzVk+yRjP6RdIW1WvlOZE8/Bzw3cIARZrTokhePkYEVt8tNoVTnOcKPlaT7Dtrduwhn5O+PWfvIR9ZCZITEASDA9dijJdLp3oMPVYtc3ILnBi3xKWIKQPFu1Bb8OXBhd3L4stNhxXK9EPZ6g0S9whEcWcGyNOsil+WNSR6whp8MAaQlkIa9ALKMoa7qmDWYbCddMgXLz2NXb0xpuaKqkNAO88XPqK/oJkYQXfF5cdlRZsBnJnQP96DSeNwXQsUm+49CPWSx/yhUCPahn1Trddw0lzcJEb5xRdwfmHtNs+3lRodS1OuiZfp7X6zQMgR5rUiXmJnSBLYAs7ML75OBJFJHvR0I/edSZJ9g7n5F7X2++tY+X2FbH4/SV9LSx3P1A6j8ce18+nR6buXzNbTy+Rrb+HwFLXz65rg/v8KDu0+wq1BOnI4xYKeBOBe+Q/eV4RWvUxFWVsXB2seeK60KMk++1WJJHvT5L1QffmNK0FMUhd+gfBusBN9LRib+/Mn/fY+CBfwJ33n2bDiyCUbj7zxfKC3tsghXMqWmGHZYWqz9jcgYutFbFKpE14Lw9wvenb4ag7tjso35V5UWJK0TdpxlHo15iE5gSM2DmK0ZZPTrlBtvYLaRw8ERwRrfwGJZmKrBjKi4hjKGXuJCMACqFlUaojHshSkhbwEi62MG0RVmlvzl9avJvg+XPWbLfWmP2Jd3hv0xfrAv6QLzY0JkrrWf/N/37Kfj0H/dKyHAZnRVKocdUdWQDcagtQc0DgubB2p1wLfJt34TDAa7IWTYD8nBh00H3CaKj3ZPuIyOpiYaNIO3qOP70z8BQGDWcGGaIC65ziDJbsxupbY1XNd2ff7NmJ1Cb2Dtsw5QAYKt+O/1DBLdIEIEbj/zCdsJUnGlFWmUf/mU5//7TmJfa7yeRvDx9eH14cFzc9bn5qzPzVmfm7M+N2f9f6g5K5yfG8Jk9BPB9jITxoqW/cxSlnBzU9zQTTFoBOIxg0ZIRQH7lyoke1MEXvBncDJqzUpmm5gPmUg4rowTPI5NoKO/fsPzFV8b6oc0BjeFz3sNli51ucAsbLokLspbqVVZtDOT6QIH1fOuNeS2uXQyoOx0JrhFQTTtUqF6gArDVTVh2ZisfCXJ5As0zP2JlnJ4zE3x5/m9vBmV8HRcGXFkxIk/l/IjKVFeSOKlpN9qnoMd7XFisVHv6xLxItSTacq5gDMdMn8g/xesOJaJVEIlDKe7IhsFoK5EaWfhlUnmvJD5uk21Jzua3l8yB5+98FEBLbIlt2OWiZnk5ZjNtRAzk0HwE6+F9AM87s0e3nWebwrrrs5L/QhbYVu64sR8eblBOfqOp+z9JXun/sFv20EqZZLobssXmIMbzV9ZhZXgeDPa3YfoYX6QHCR7O5PJ/g4Vyuli399rm6Z/HB2nadxF8P/oYuvdUF8KYz8e8T14u5UZs3pWl7a+j9e5Xsmyiz3N9ksh/1gegVK+B8nkgdDw04jgK7oT3hG/4Cg9yVWd+WuFGs7NpkwK8Aqd/Di6a548tftJITJZF9CoaM5uiyZz3H0d67ok20W7ciBKZud6i2OjzVkdIA6d2W0xXFePTHm5KwXhMvQnIq0jJGbXVX/ZXu6/em6f+9w+97l97nP73Of2uc/tc//Q9rlLa1sR45+uri4eiCBQ/9woiQk+CpfxEl/mmk1rnU/9tTiB4WS8BEJIIZI6NI+BYlrCfELs2H8wU9k6wbS/NoEfOsH9Rdv40zZx45TCDpoMR+2S9/Xr7+5GkZJgH4Hk53DCFRm0bjHuxfInkecKCizm2TC2G6DllYJkZHMfRV8AshgZdZ0AB9TzycHLYQJDdWSVPQLnzyHtqEVSN1Qk4pDyyORo8bty+jMR36y3KkSFXclNX0o/YZeCSpKptC58mnaA7VsWb535e9NgUr45uRzIV10IO4aWI/C/tR0kkxZzofXGspQ/EHg6Z6VpMWNvNUH2mKPd3RlcS6an0Mtpt4M79er70vucOpU8cqPHSH7ZnX4fnndvdY/vl97rhO3nbXZCGspu1WYgWPDPF6Fo09QNNBwzONhrB1o36yRAvGiIPqXQCeAR8SXc6UR/qxYPHOij0160PlxUz9ViASKnEHAlUpqC9Ax8GKrpBGkIvAm7OWQIQAZNEzJ6MEugNxzB9UU88eqo8JeOw/hR6lnbOHElHsJAMxExA2betEojfNs014GJ+K9CAvdQLY3ODCGkAJMQWQz/21DZDip1aU5uC1954dspNflw/gzIs2g1L3+MNoQM12a+J9EwR+99UR2okhRil7RYRPReeSyiDb1IHYXcdW1wfEagNBZXgywNX38CnPNRLWe6eBr6Xy+UaEp4IJBp0u2ElClhytHI95ldQ03rxsXkK2ZUtY3XM3ATFEzwyGARIUoM69QT2e6Vx25VNFxxXU7HbCq0hv9I/J/GquH5QJ0NEXrPRJt5IfQG1jU0cm0Wc4FMaeBKPdw+hksCFMyl681w9ahGNo+rcMRQXBNbF/9w/RhIAQojoN+OvIPct+ofdN4rvUgEVDaVqat4l8yUslAotEr+4v/VIpYrA5jAjZQkasx6nyym/rB3UQigUBjdTzFcaKO2ERG7wzlBk6dSVM11e9bdMp3ZHuzfOZUNOh66XPBEk4tu0lNpdixt0slcwA8Gb42F5U2g38sgYepyoDfF5uhCw1EBgaXKeqTokKA7JdgNAxPheXsGTyOz/XZt1WsHavsalrxbfBgVyugNAhta6JsqlxaDFNJC3XJZNs4QaB0ap4mclchCmje9uqYE1rsDHPHiyC0vo2Lz1Iw0QPSkJShxjcX2NPxkx70J+bJ8AeaS34pQTwfrhLmbqU7kYeYtXJJyEQtRpgpDj3BPQqyw7TPEUgt1G28CxdIcqmXVVRfliDxRsaDHlwBlRlGFTzjWZsL37wxQZ6RBtbqrfn4lUEwLwlDGu3XQKD3rOnWpzbiDW88VlqFH7o/rIbbu7T06akOtjnYpPRmrFZgSCkd3IW0skW4lp8JIiS/hY4RgH344MezVwf4BbOWXk8ODtrOONME5T7FUf7IJG2MUzdCXcfMD+pkGQdINJBBArNrUlBprZgU7G6ZFe6Rb/ZyX/sgLFdx8h1CG/Lf/ss8c+y/vpdGGzyeiFKiJO9DWPXs8sTrzQKb+bmguvmbjI6bxaUvdWeY7akN+/hKLptykNOw1+7Yhzr8FTTVpy56mZiKYG06+i4/QjZ6MLC+SSZgERkEGmXw/6XPI5OWrIbIGBD59Gz24YzzsB5mga5u0rDeqqweivREYsanSXDLpDhzgOip1ivthUb9xbJWAWdFDnnbmQg0W4rsX9VAb0Bs5vOn+4kEgAnDs3VsekGbtP3pcTcBBmeDf36TO+lUwQxiwneX1KCYASXYXB0RG7R+4+BEWvXV/QzaqX3kqCBe7nM6jR/e4nWAdfTm59nUUmG6qiqIuyQJ1FRGw/5NTHXlz9wXvtns48XWSRieNRvqsyyseuo9xEdhuobxQAvoTro80VvamtssxLhSV6beqY9uTH6bSyqpU5e0uR1zPpNVcNxmKcCnNyEVJJROxlaRxOnIhoXIwleobo0LKc6NQkcaeR/HL5mZdRS4Zmf42hpNLzJS6GTO7Al1OEzIrv04+47zpMNU0xGW3oszIeUI8Qbj4yWQCTqEs1EdoKsjiztyFdpTs7MKVijAQStFQ4DCCuZLaV8b8CuM/XBYt1hpw7fesy09x649cDA/BQpPZ0mC0B291zxTsG0wskiraeChnp1SdF7+kMvZR78/w3PftGbOp36z0k/MBymYlTF0MnEiHnXZuToLY9fXGUkxGx3jxE44dEswwu2hy7OzCXUclboo6ncc+NL/9mksVbflHOwEzcqxS+Q5flAo8Y1CMt8y4zuL2ewHsPFereDHeCq6hSxtcL7Eh/raQdlnPMPIGDILNundpeLvekdkOHDJ9ek+Olu//zZwf/PRv73589e5vu6+XZ/o/Ln5LD/7+19/3/txaisAa7XV4Em/H1qkH7k9/L66t5vO5TJNfyw9R967Guj76tWS/EkjGfmXfMlnOVF1mv5aMfQttGKK/wGzSJc/db+Jj/FddYsOpX8tfS+iUHsMseFVFzbxR6LjDi4yZqDML9RcehwMp8nPEMIPkAjAjw7BIBkz+VopV4nC4Y2BPGii8L7QshBXaIdJC+nE4NYi0MABMUOWhwWLIYdBkq8tORPsW38yVXnGdiexaVg+wzj13JM4ufGZgU4qZtmv0E/nLKq0+9gOpk+/3kwk0JmmhJ3nJr5051cbuyQTM2fH5Mbvw0uEch2Iv/M5drVYJ4JAovdh1BzPkCJhdL092HHL9B8nHpS3yEHRl7JLkCLrrfWcQ/5Uh+cNzbC+AEgxVpXNhf8jVCiWcwX9RWlCACz2AyN0Hd2uA9ENz6hH8sEXoDZoT541yNIMq6pC3j435lT99ffRaBo7uYfsjpob8IueyhbZrhv0Jh/DQgUtAPuvIpW8HDt3ml4Fj1/8YQPoDePjg3T9oz5qW9oFpf85ijd5+562LMAyOmjDxMWGwL8YsRxb/B09vxk1QL7z+FWpuIQnPUzBgvQkSXgLDcxN4ORJiTmuHi/+CN7XOBft3N068DZk/bBsK53wNtdfqrBozm1ZjJqvbwx2ZFtWYCZsm218f5W1afZHrE2fu0Hl/eYalOnNmW4YN/ObZ+i1QMQHaHTgKRlZSZUQ6ZpUskKBfHzkB6cg1QM0YdOwbeB8/u8c5cFz6Xg66V6sC1FFIEiYOHocagGCtDZjUmatj7ZNIMgH1E8YePn5EiSUPQtxpn2+kXIF0dT38GzlMndNphUOo21encGhCfQ0cgdFUO2X9ISV6UeswHlRkqsvHEyB0nIq6i7WrZXhflYHuiTOQkh8ldMmRpdU1XlVz5JKq3K00zhcehouUhEKkMhJgaN6uNIGNUYpGxIvrObQbGgINVD2+eEekoVsdQFgRWCP25kDB+budOSStHN4uTlCu/dZCqrt5msAXxqcZOd4wjD+C3jgLgtr0FWDvXBASTg+M7JUZe3P1Fmy8SkElDGpTLEvfaTHS3AMYr0dAXBBcf9gjJxNaZIEemBnz5uTyEzxQzwVCnguEPBcIeS4Q8lwg5CsrEPJ/2fvS5jZuZdHv+RUoperKTlEjarFs+dW7KR1JJ9GLbKss+iS5XyRwBiQRDQdzZ5HM/PpXDTS2WcghRXo5hze3TqLhTHej0Wg0Gr18OwVCqvVB9G7jh06s6KFxPDBzwW+moMW7s/M29B72DTogds9tEGSdw/gFcRzA8kVpsuDNhnu1Y770LnImLE6hfomTQG0A85EN5TK2mSZEdteDxgWxdBSYJZ0QkY1pwv/GtgIG2tWIJMKN6wSaE8YiFqHmARtE0xWzUUHYNC1m9cPEwR0Yo7PbX7YlM7YlM7YlM7YlMzZUMgP7zW2IVDitIoYWDV8hMT/s9z36cpZxGm/2mkF7ZRAZhrwHX8Q3BhwqZmmNM7olKIUdJBRTmG64cPL2RJbJPl+JcOr0msbmFhKUCQ2asjT0BVNmMnQIude7oEzZiHL5r1T+S+5I8j9EHDOZ2KH8HPBf1lfRkNqhYXos9WIW1snUf0nA3QTudjalSVGxJhvX71pIM6KGKNx6tq5N4TkNq88XRBW5cLSDiCUZBA7JoxDoZb/EgAn1AZcMTbR1AeaSPPB4wliJ+zECOZiwHA2cXJpcMgCLZhk0EwRP0YjHBbYKVanw2piSEeBQV0z4hQYMGXY8yySFfYXSGi6pwZcyoT+4863NGo1X5J4oma3j1nbKbxcnUE4fdDqeiYxtFp1qE/7upQy+S4v2Ozdnv2Nb9jsyZL9jKxbH+aUo7yoa1oQ1PKbhg07ZQi134zyaq9xytli3yZyRvKCxykNSF0oaq6bvyunlrmvJN4DSn/VMGCaMoWdGD2Gb/G8XqowhNaCREAUT73YsLCjjBvusqULcWQO7ZdU3NOM4J0tXcA8nLHzIy00toXMEr+1EO9U4VXJrh+BwnRtXI/rkzfDo8DSip29Oj9jRcf/0NHwdvaHRq3B4Gp4e+8cZB/mGRnRh/9CDwqHUKP+QskSHgqWZGGd0Ks8ZMU3GJYy9EGRYcihgBM2d2T6ER0KWzj6DyEJuL/uIvWr1hovsvMtDkbINDfgqieTUJGMyEU/ugGXDBDOj2DUEKkPtwRKJe2QciyGNa3xRj5sGwqIOg2hrDTqA9SlD8Brp8zkHPSOTfFM8271W4LFMg2034VIGPY6o34odiltRkpu6W8hT+BIJ9qxiCJC8vbn4g2h013A2lVHrBmQq8pwPY2bj+vI0+ixj+hBkvq87vDhzdJbScMIM4MOg/6WMBK3JHBRWcoRHxQZ7Zd5ASoiN/9fzxmsC5VC3X+bZvhT9/XMWxzTbH4v9g+DgMDjdf2ZP0sXJgJptXmWcGoknh4dHvoMK98YO5DxnMn0shhzFHNfaUAEKnrXhPGq3Nna7mhsaRfO5Gm4OzNnaFinEEAkPHlitFhzh0b4jsRgQ7aWIAyZyr9GnsljhqIAtooAi8dj3WaEivMhZPIIgEYQo3VQp5Ixj1rvSonjjAbuoJtfef3azTcb5hiZ/9yzL6AxDfSWTaDaW0Yzute47OoPLGuW9UMODaEomc82SnMumaA7ja7oK/9wjualhuUf29OFrD4JftBdsD3y08M+BHwHMPrOwLGDr3RArzoa5iMuCeT2NNVcs9maVMuTJvh7btv/8f0r/eUebrBvn7g1KHuBwliK4sAs+ZaAHYYfTViu6euH0NOUxzWpL0Cw9TXw65tG6d7gra/mIkVtp1+gXZpIs5OhUQS4Itvc5m/Joget5uZ0X0fG6RXB8WKm8k6br58sNzbACFpCxS9JmQvxtPy9oVnSgpNXYnjDbhl8zXAJtMIx2D/sHJ3v9V3uHR4P+m7f9V2+PjoM3r47+x6+wW0wyRqNg/RwaSMDk6mLxBCENG1x8SEyjQ1Fh3/Ntbel12RAxRhNIJI4qwJvFUD7vqRI2SjWYwA2am4kHQgOI0FMOlSGzyexvDUgnPIRQMszEUy7vBHXlHyRC744Q2ZuC7Yi9bmKZP5PU6zKvs76+HtBSJfYhSpon47tIlybvQMxqksM0LszNgTLo2gmhzdoKtfsTMWX7FM56jp3tBpqjnf3ReTTXzjYJejmTnR5NO18sDgIGc8ofhZxWmkGSI9jJnEE0+kgPjBbUiBvsO+oFGUrtWtkYypNDwDqUhaLJjKQxhTfV5RbUM8S6YAOXBAStirsBJXiHNO2pyzH4lmr7FKK7JQpM31QiyNGmhsKgkVUtWF0pIffIxcBWdjwDP2WYscJcBQOHbBQaBL/b8lRD7SCAVEsTGp710GmkL42dzKoeCWPwC/XwLljFsWPkeOAmNOqyhRCwz2AG4hjShRFkISz1PL23xRwK8J8opmE5dhX/dXVDiow/cijQ14MgrCmFJHrP2cALiYxmEI01nJkkEBfVWxoMgzCI7pc4ovC0w4Jqjv87i01BSciVlnMsdMsOXUpN43Ei83BN3Nonc5bEGbltSiWxJWkjLGivJwqEJMHMF9vqFyPyMzaGTEnw3bMc3NZ5z3kfMggyMuQmNw+OgCo1MhRZZA9WUKl0cH6DUFV0HN6NYy5qxkLGH601hSUVye2f7zEt8EX+En9EoADQ0qLKoaqyojoBroYJ3fXxrMYPhFnJqU5yisClVsB8DSgAX+rGgBJSwbIp2THwdmDbkBWoHbCaiqRCeK4ba8mf8eivw5NNUoe5e0WIeJUC5IFiyyso3HGgQrr1EMC9Bpa4RIg2m0R1KPhLFwKUvgW10vHrJmCWtbZ7gQUJq1dN457cFVESjICcK/D7egim9bVUNHAESEBrkZxBBAcPdbI2MBpqkXyGIrpw1yL1GQLlKjQBokELQR45DBccUjbAISEhywrqFdqwJVY1jhGNY62rpGNExqMWbCwyrDyDBVbygscxYUleZhi22lIqARg24o6L2Wl+Hc+W0EaoyTuopJUMMin1WI1HTYzZOmRQkVEw0yEfl6LM45mSZjc5iJAnYEtuznMyaImCGu8RqjunSPVeys510Lm4CAj503IWexe6TRUgl4nJ60OkScv9fYAPsASjETKILIadu3AK4kSlymhSvp77gKeyQvE9NqS5h8iBlCVSBZqezsIUXCUAjetAFTMredA5hq3NEMS4E6xgRuNYGCphkJBpkIipKHO8VVV8t48RptEUCOjF2e37l9jUI55ZB35OGA0nRmdgw5grWQmC1ROGDl4dnJxWx+wFxHzpGBiPvF+EGMeMXF+fb7ROzD/gB3AMFrY+BCownCalNuvse+NffDZ1P6pRtgqrUE8r+ME2G26bDbfNhttmw22z4f6NsuF4uoCG5sPobj0bTUdN4+sE1jXIVSVSl1zdPMqawlc3jyfWIAx2v04SmxvrrZEntAh42mFZt/BmAJU+8DCUAiLXeB9SSA17fzYwZ2IshsnRWkKQBCzKNOOP4IO6ePc/blEQf63IE1YsaESGNIZCCbBadRi9OmRnooRFXGEyjLNePGWRnbnYR+0yAOB/wyzAAj4+B+ZZdd5Ab7Di0Co2nO+r71DDZqkpuEG2t4l4xsY8l5fUd03WY6MMrLLiIJaBTPh4AmWfLFLNI4Ub3JgZT1MWGZLLoTY6m5tpwksOODwFgp9gZyREMJYWfBCK6Q54snacvx2IA1v4G12YUNoim8rcmDRjIc9ZPMOSpSpZRhbwBOSyBG5I8nI04p8NRPmOjE56u7+vXlFvQJDSy4AMshlWqZZH9s98agrFDWdwJZmCc4k+2FlV51To7kGKJ0FiOmRxro7EUNpCHrdkmTEY++D6IjcxnjuhCMqHhuIzlhmeSBQivZM2xBeQCDYagfvqEeIGU7RccA5fsMH1xcueun2R1QK1f8ojiyDre7p9g2QR9kNwXsf7nJrwVPEasMBHyyGAvvN9i40UmTaJsRPRTXbk821T5m1T5m1T5m1T5m1T5m1T5q/alBkLl1evOfWjOfec+s4Myt1VL820zSx/g1tBDE+3oe/gGEHiIPo9FHEsm4I0B+KaINwRh8vrJHKkU1Z9hY0Yi/WNeGhwg8sKg42XuNNh6YRNWUbjDRbzvtQ4XPUk0BukyX/BR9BOkLDPPC9ytwmgtLt5hFUX4xlR1285oVAYH7IJZPRVrqps3iNAufp0O4dgtyocb+jx6FW/P/KYsZHltPupun601GZlIlvdaYp1Rwr8G/Pz04znjs4RIxUKkoiIoZvNG7K9bTLhSlJgYK+GTxoYi59U72lmLjFYqXhKHyD+pLDJFa72NJClnDrVG6UqhdmtSq0fUAELBmxyHpYxzSS9BiRTfahsyw5nILYtHpclsmVhHDg25ViCEwMM5Lr0yIDwEz0dmiQD1rlsxQxzgRey9/AdqnS4wJN/gsBhjde6vEVHr9krNhyxPmUn4fHp68NoyE5H/YPXx/Tg5Oj1cPjm8Pj1aFF55vVIpLsF46jxjtrRTg1FIUjS8CHP7coE9a+KPqO8wFXik5p+6KOS8WGpI63gH4QBRzJayBKcwmmAB1zN/e0ZKMaQGpWOkkA8lPQQGaBo6lQifLFUrCw4LEbk0u2A6a8ivVOXtgseumZK8B8YiPao+A9Gi7wJiDpxRWxEyxgyMGR3GDHyXwXNaktCY4yVLPek6zyhuLIGuWLuOPZwuflCBHc6dUlan3rX0kSNSMDC9STHlwTwTMAr1qsKEPTHWitqixV+A0kghTAQ3TBLDo5DEDfsudVzJkEP3ahFe28w1IaNAYrbiaFMB4BpaN1kqaKSHRLqElUhINHp9tKHhO/4gooyGMhmkBQiI2ymFqI0XXoNXNmSEW8hQ5ZKTx+12BTFksXauEIiWabIQb9F6a6yQmBnpHHJ84mZNbso5ZKG/QL6WrpbPe5zIgcfjRPAQ3RLKORLAmEWytltVIIFL0beoH2pMRCN9Lwke/CDHbUe1JQmMjAJwnHry0vj2+vj/1XSZ3In4HKdKvpcRf5CglRR1bhfp3zQUvuE/NCRGlDi0rxusmc9O8Hs0I5hrkfiIMGqy29BlKSxYav5ylghn7rqCm1RvaZu+L2nVe8XaF1vOvx0v7XOyL90Lr8/ISbA7InOnRWrg2XxffEAN8EUi+awgogknuGR15hHOBpPu9e5cRQcBm59chWH5h2z7JM5pyz1Vu2AVYtK1IFukip1JbPvm4Q+JCf8cEHgoXvthNGH32R4HAb6bcPjtuFx2/C4OeFxap3gNDmL+yvGyOk+k9sYuW2M3DZGbhsjt42R28bItcbIyc3iu4uRQ6o3GiOHR4AFsWE0xoAqBCpDxHTYWGN8mJMqBU3O5AEoGa8ULPYl4+Va2RE8kx/fYLxcd6PuCwbNNcj8Vw+ac03NbdDcNmhuGzS3DZrbBs1tg+a2QXPboLlt0Nw2aK5T0JwszFS4lzkD+2TOZc4/wd0v75rCGFqoj2Y6Cgf8RjRmGTR4DaF0h953ERcp6Gfwp2tXi94wgXnveJExcjYY/Nf5b2SU0SmDAPXmQDq49oH7LGCvTwhihysyuBNDhkAbbmky4xkSYV5d3PbI+1/++Tt2W9aX85SQUEynIjH0Kre/GkRQQLWMMPhJ3s3oQkEIMqQpFMSWswDZLmgl6TIPeoKQHXiC2+HTlIbFzksfDQsncm0GPyFwZ/SmPpFGqK5MHiCwEDxeYOjATQPHPqnDGdHuJyikbRWHxNUDHtIQQtJiuPIHEseCxpo+lkTSa0gilkA1FTilq3vWHV1mt8s1mplVfzVsRJUihw1Kc1k9KjNZ3AWnBAp5gNhqCUK4wC1G1ExLJWRmQyPIGJw6IRhNYgogMckgQ2hY/tvARIMXgyEiOLVgr2BT1T7vEQbWsXSIUGgtPoaMKah4oRwSrMgE3OLCdmuKrhBS0PEYiBG4FmvL/93V4OMlri9vVlCcN7YVw8rhUiqRnVogpTxq7v2JtZp0KRxXHSBUKKtaZPwzGSg4ZgbRtevUYgPfCHa3h+rQtCho+BBMASYcCvYVJfn+4KzfP+7vGwQvq1xTLzTx6wuZBCZQozvvECTxVeqX553Sak28kzWNWBJuioEgcgYHKbP4O+XgUhAMj82+8SWWtFGLPl8lfTW+Kn4iRLJ+vmpi8v3BwfHp6RzOyt9b2LbBle1F2mqE3xnr2o2BFn5+ndXembsIklguf03uLgXD8DqjofZeaVPeedRuy1/YyG0NpDkRgCY0nv3NSMoyOOXBTQEoT1GOJ6LUZzNKphwibTF8zW3bIo1xnsg8kEfOnjCInOeO2YnT5BBOHBueZAzEs8jJnr0x0OX9oK4q/q7jk0aZSIo9qBLpSicYo4WQVRyhWNWURmYc9oQ3pOGD+2UedDZxgYkbVLztGScKsT18n8n5RJdCbseGJzXlUrWVCTGoV1WXJoUYMzCSZQy8AYky2tNOAM3wCU0iKKw9nFk08uZpD6/cMM0f0gGC3arAHw9Hp4ejo1evXw+PjiN6Qo9Cdnp4GvVZnx2/PvKDct1ail+HyQZ9hdX6uXao61sbE2ggzwVTRqGoX2RPmsgY1ZHJgFQtrZC/sPx07YYa+/r9Uf/kNaX9IT3tHw5fO1qhzGJXI3z6eL1AG3z6eI1CbeK18zKFY47cSuTxEKrAyiKMmfTVfPp4nat6rfimvhEEHgwzJi9jSAT3EDyBtJ0QEsZ66B3sydYB+L0gIum+0DbrHr3AaEl0p2Sx7Zi18/T0FGAUcRAK92rjCjojyfhzCN+nkp9TOlObE5aYhFCAJNoHFgJflUM+ntmGcTpU00CF8cqsAGlZQZZLD2u/mut5Fd48Fvrq9B7DKjEysyY0/hA8vkoe1uOc18fage1orluUAckjHjOL3Gp4kfExT2isVwPCJGDVO6wf1EHwXAU+y4rOI8hsUwmIPZhFKIDAHlk2Azjg9yS08n0FeMyojE5NWcZFRKYl5FSIAq49VZNFyFTxnONAPZzkmXp5yMhOmox3bBIa0LATwLP6sk6TsTcto4yOp/Z2f+2zAjfeXLgST+iowJbC9z/eO/JfiNS/z5MvgN5LhH+HqIkOdv2xbK4N5tVIQoeVp7yEfArqC31lskJymasFKhfRzIlRlkVBNTpZofUeZAzg3UNBWLkjKisSM7kgQTUUSV5kJbjUwD2D5Wa1EeIHaLuhBA0mn78q3x4fH+2rNISf//f/4nP194+FSD2O6kWyIa7ufkqmIoKdMLLrEdYNxerT7mjNKJtyOBMT+jwVCS8EBGD03GbLkVGaQ+gapidT8j9jVO8ucnpoCMXhZaaJggGfwqqXDYj+gkVn6v+CMw1UMuw3nvC6s2mcquYzAxaawhWyN5wmtOfth42ZyCtNLEhRy8/enKc0z52ZXPec3yB4vZZR/frXG5vuVebjdnQQMmgnWBAG1EjOyqFANTqOj49qq/n4+MgjShaM70DVKkyS8UESAQqxCS6V9Kpf4ISXjBvHgDCJ5GlF2Go6/ud7WCPss9rs7A7tYpEJgMrwwas92BXI/c/3coU6Dgy5XeC3knadI6/a01P4Rmb967d6DjL5AW7nBiIYUHAjzaZpYemRpKs37/Fr9MLrCwEv3ZQMWfHEmLW+AClktcKWoU8vemq/dhgYqOBtDNi3EwOmDjebEoJbCb1xHUtigWe5OzlQO0F5x+7fNtpnit768CSkbXTbNrptHdFtG/Smf0LwlTXhtj8xfRK1E0T/3e4FkUII32lfiN5U/cAUk7IqX1XmLRwIYvZIjc1fiIaqJhg/IdtyQv4++EQZJBR5YSbwhLMcd1QdnkOm0OanmFDlSuWRPk5qh43pAKQoUkfT3PGjToPdb8TJ0h6DtvHAxK8Zk/gdhSP+u0cifgdBiF87/nAbergw9JBHG4jue07U4bcacAhv3dGxdok5WzKxTztszAqG3p5t8Tgoo4BNqnUnRmMSIHGDCZvpDtUT8QQFbHgirw/xIgbGBcU2puDsNWfclGZwWiwNqfp8ucReykz1KH9uNrKSEVt1SvjNRFdVaBeWjRBkWVcj6paOaMa/pEPzU4IT6lRB0UTeNRP5TvzN45juvwr65IVi4/8h5zefkKXkwy05OLw7UNb8OxrCgz9ekrM0jdnvbPgbL/ZP+q+Cg+BAt7cm5MVvvw7eXffUN7+w8EG8JFgKZv/gMOiTd2LIY7Z/8Ory4PgN8mn/pH8c+H1vRR6M6JTHsw2x68MtUfDJC30IyFg0oQV0tRpyCqEmGWPDPIJrrCQST/nLGgPVmzW6N3cX8CFlGXUiK7UxJE1ieAwzbgQAKmNh/aj63KvpfCf+oo/+4hF58AClO77YGBQ2Q7a8t4LeZaiOqpQfB8dBf+/g4HBP9tXjfjqzyDepjlr4r+85He63MfyPKrXaRPpSFGt8KPchSwqR90g5LJOinCfrNHuqmNIiD3C0X4p4RLdQRg76wUFVo2yW1Eq5qzlbA2jBH/aAH2/JUKUm0CSciEz9uafC9H8wtgSUa/yhgu2/JQnn2h2Nkf3wubYgzOFIGpfQevFR3v3MUiiq2rydq10CSus5S6iJJR4tv+L7eug4ag8yUBZAvPfftgCSAkxjbm7A4OrlLToWKi9P+RgkAThdZCXzoaux4JsKrBj+xTBOl+AfdwtH8t/40OGsnEeZyTMuMzhrIrKm8dWYVh/bROTee3OHJZnWOBt1wI1TNxc6MDhn0h0DWXs0CTvP+AD6yatvTclBwiMt1GEsysjK7zn8qX05su4dxRLTDcx/h7+qq5jQ+zQH34OOe2Twx5184U6D1L21ReZKuDdq+UGQZgLEw56SzeLEX/Y+N43byodrBeInsM6weJQcMZBASANyPqVj1oCaTvkeHYbRweHR8XzsVwCBXF2Yo7cclZkKlM0fyRmIiXxJxJG7SjRBwLjAsETOzwI5a3x5rpw5ODSBtnj3fDRmQDxaFVOHpVPB1XX9ONimFDqXM6lgOiHDDwLng664UK/zmBezuw7adP5XXbGijHeduNr66ooHbh9F0gmH92ojfK2PImiam1mFdKH/blhe6jdZyLRanhK/g3Wdg9fgTm0Lb8mIxjlzdnGFb88oo5bd1pDVdNr2P3E/Qx+PmxnazCyHYc2fNDKtBRVonOWxwVfudrck1sqX3ZCujg5vUcmPZPDh4sNb8qt4Au/dlEJBYJaznx2wDVbGAktjjj63Ol2REGjJhf3cyi0YWs1Se5WMhCutuC3A50TrGkdA4XmjeOK+cXl+i49UvJmpLsrCPJhN4wDfU/mrFFNDE5Hs2S8rHleRFwslvX1qPLeoBjEUImY06cjekeWI9L7baa/jFXkwLHlcR1mfUbN77xy8uTjon+50I+fDLZEYXOdsMyFwgm9cB/NoyYuMFeGkOzEai7pXSWZGAh/KIZzOVfFLlMPf3GcNcO3vxtjzLTcL1FpsC7Wq/WihZrWvLpS5KsdTEQUd2T2How4HUhFJquqTC6hKHq0N042IyKerizoi+N88pSFbGyoLsY5MV5BfHzLtw6ojQ3X5Uw3XsorZ+fluStOUJ2N8d+ennaUpxo1kStM6ybLug9z/vj26Hdqaic+YLHkMtefXOsUWbstERyyNxUzGXa8VsYXbghgMQbh2W/uQHcAtqO0OtVbEBuxCtM1G3/PxKri4waAut7vLjXnQABd/tPuKOdQ27QMW9nKbAPvc1exEDAH7zMKyAP/QHNMTR/yXiMUDp3u0LETE81A8uoeT/6d+JRf4y4y47xlfSBfvSQModxdGOgzINq8gvhcoF5PvRW0SiQa64P+1gxSriYuRIQAdhu04ebQ8ukuoFCI/x0wYc9mMZUUwlI3xYmL5GpGoBIcyHACzokw9n6Y0hEU2hYfUOgUBMwTi0SnUfIOgnSEDEHLeZCUMSKOBiCf5ICwgzh6A8kiSlkPODo0BRJGr2Marm552LcFaIDzqwasTMNN8kuBCnxc5NpxoYiEm2aWZiMqwWJ6RA6x1rdYuggEzUVddmYt2ZXHx0O7mxvP/wsH8cgHqJBLZapjVt5rVdviOLOROE5lmOnSu4tLYIdR8AodPyMtR6FBaJSXzmB6WNt+n6ZjUgvV3kwmkxwdpIVrE8UhJy2ICUT+qAIzOENGKPJ9NaVKwEK80UJ1Vnjagv0weWSxS7Vgn+YRC7PZwhrls8jqAsM9YmUZ6Ym4RKrnEvH0J6ZaFJdS8Ji9uL29fQnoNLQCGXCD2+qNNz8k36j48f9Yg9oJnLEKVvnAqby9vHdRORhHw1w5KZD0iWwe9O7v+/ezjJbn5+GFweT64+vDen2mg6Y635n50p8QG3SDmN/2jAx/XSmgsXL16cLyqDFV9Kiy+0p6GllwwtUAiD7ePJWOju5UQXbWMTK5SiUglxULxLnuOdlHDlQuCU3gj245pzujgMwcfSE4IBYYgMSKpRcZoZOrZ3XNwesP0UPVgL5zyOOY5C0UCy5Yn2N2HpSKc+LTEYvwsQmAVxUIVg9IELUlC4+VjN5HWnxIxGoG5jmxRDFZUJGXBch+h3NbBSV+RNagiMGbZYrQagDcNEJUipuSAvHA8RzR+CY7KE/JiRAsaV7ZFrPGxOiFWc2hQzkqrDLqgRZmvjEmURSim9XJkUIbgkReV5QRHqDK/Uw2Xll/PF7VGTcuhD8u8EFOWraZNNGM1FDvFWLAC1IiPUMX8Pg8dxg0vRoaGXv0CrTM6NyZAm40ygN/w1VDRjPqRZath1rbic5CvzOX6JrQS/pgm49UIgC9LuExYBf2IUSht84xp1+gQUhtatDr+efXx8vez6+tmKjYwCQi6Gd+6Ja4RGW7MK48NlnF9fHNMgNXn0umyuRCLl1GxJB6dQI/aafGQXC/OkriAfbh1Y8bCQmw89bezdDEWp5DJ4tGoJLlV0GB6nYPNopKZ/bKUAZgMjYjHTLQ6x1qw/sKE6Tzks07n+oHzwrvuwXKfKU9ZjDeR87xvprGWq4TaJnoOpZpHBp4XCOvia2zI9SyMzf23LM56B6BnoMvqDX+c0VVatD1nWNWObBaLlgjnm+bmXB3QxHNadlXkV+Tu0JqG1YIL5EJUg0sXL1SRr75F1NBV9oz5aJvO/d3N6irqigegGwlDXuRrwu9V0fOQ98jRIXiWTo4bycCNIl9Wa7mbDLAtG1GnK7M77na9xNNHHZfn8EAjnoNcE3B183hc3RUMMfU1xdPHk+fiO1kCn+241SbdHXA2NNiag3JMC/ZEZ3bnW22gCKYFoUYGobzPUBfwuXZCeL4Q8gStYY1hS0QYQjvsiosWPl+nPVvPkIMnfimDJZH41lcdATbpWn0UsRiLRINp4Jj0pDf5k/fUPbcTgwJWnH3YgPOD+tFxJwO+lM6gMZiUTX3v4jtGW4PjoZLaKmoHvnPGytHhsEjbOILaxuk5iJvO4EBIfQk65Y6egQfyv2X433xkIxHbqOHnoJNw5uPS6Xbukn8GSg2uxlL0yIFjW+/oXlBXla6c/10nx3FEtrUP7kAjgHZJA9+kBNFAxYQePp8pt7+e7R2+OpF3ky7iOr5p9Or56N5dvOqAKufjRB6770IxTWmyNvNXQTOYoZgD4JKXVXUy0EXvgKm5vbsgBSggU65DvoXFWM7umRhjCkGjElY4D7VGS6EgwSqKUV9uSwcR1mBGD1GL57VdW6a8PuzKmlowbE2N3WnrHC4bsCwtRs3uG0RfxxlOozs4NT8fcSimU3mi4glbiFbGP7h3NquKk7m8QlQqsoJFdZRgto39G5O2s0YHvAYaVj1aNGBTVKdNkjvgxKo5dqxZmeSEOtjqEuzSUNFT8+Z6ATVN+7816Kp4fbleA9q6fLcj9yzWNeFvs2Rb7LmVJ9yGfbXK19eccX+fmGP1rQHvAgtwrhW4DvRzLMIuVuEaSHi2hTjHSmzd1eZZix3p7mA1zrEc18C4xVZkuyW5BvTzrcqlLMs1UIOQF1mZ8y3N1s26KxGLrU6XAF1IeY0ULGuFFjQbs+KHNl3eyQxtsDvlHYq1Te1qrSr1rSG6NUS3hujWEN0aoltDdGuIbg3RrSH6H2qIYnuwas6Lq8873FFbKCtcHeWZE0u02o2q6nfitGMKGrE4zSlaFUM3TACpGcdarqURi3M73Yyssoyeh81Ez9VxRXnx3CmKWF7wRK2OefMEqNYwTy665skCRGuZLBfV3BkDjOuZMRflvGmTZbjhDnwdJwMDTWsTu+p75IDwZChKaD6fROSQiLKQf9aJSjNRiFDEa6Hp6uz9mYHo5Gr65NWJkPv0HU8WSVmbibCALEuI/BB6VDH+2HR4k7/f2f6dm6YkNyHULhWgS2xwZfM2sABZU6AlKpauEZaLjP7WSMt5K2kB2YuiLjtGXq6FgrYozMWRmGtA3xiVuSgycx3DrkdpLojUnB+t2RHtvKhNFz/o7A2sDFeBb5fHdnl8T8tD41aN+3/wMDqrogULQFdfrnBU4NGibXLO2JodbIqWoIaqMpVt07gAn+vSaMPkx0o/wxiCzxYhy3j+sBZkAMh3EbsoNbpUxDycrSIj6kuVjlVkfDyGHm9WZBZJyvqnTxEU/DDfCbwiorpctqFDT9jzUWqX2gJ8WRl7KVnPwIhTChDVvE5pEU60VawR0sqJpUk+WxDBsLAFeEEfWKI3VMxvwxw+GsfiCYqaDGMoaQn9VMn/ljSjcouLWkJ6lXRDLbfC+Euc4F5UafZn7PLeHO17o+J6UXr1FNSLKfTIPy5/PfvX1YePZ9fk7P3Z9Z+3V7eq3dTlHzfXH64G3suDs3c3lx+dR/IwNrg4u9DPukQO5yF9RtYufK1mV5c9aVUO8KorWktichfnElgzlou4fJaMWRAav8JqjpoalxWr1RlqYegOZTrde8oiDg7MBrceLQoaPqyid9WXpGDhpFKlwtinlcG2K2EDxBl9GwfmkKVJe3c1+HhJzgaD/zr/rYnALJhDwXpUWAsJvoFmkIMuCmHs+boxS8jOyPMWrYUOWl9Xaa/t8hpKpz/3yPvLwe8fPv5Grm5unT/eDy5/+Xg1+BP0jhzBxeX55Q1osk6KR18TrCK3PCmyUiY1p5nEBf9pLh4a9pt2sd2AlWkIqUvJ+i2VCjKDSE37nbnhX4XNGcvL2NQU0ZLEk4KNM17MSDhh4cMiBsNMFdjMtIHZvh7uMHgJzzdGkbIvlcMlh80ii7ZxNT6xob8SVdMrLAO1/HL8/fIf5Pby/JNccnaBQSs+04T499tbcnZ+fnl7K8cg38pJPktCXZ0KIJkKVb+zoS1MdYv9uJwu721rt1o8rLM4yd5gquf8QrFhn4vnrxTdz74uGli++pnwAYpmfiOaSgzBimjckIFGNF4n5Gcgyr2ex80jev59Ter0eG/E4ZQFyp+JC1YdguMsr2HVGKHf7x0K6Cqy/etgcGOb380X7SkrJiJ6/mRJnApYnYW2tdrzEdmGQZp/lZFatLLKWMay5yPVkBpRanRPcyqotsAfoJ60eg4nynTKAmUNpfQeadzB+UDdC/VnjBd6Sc/wRIuH18H5zd3787t3V7e3cG6FPy8u319dXtT5PuJxwbI7tWM/nxh/5weHMOw3CglPxj3y4R+3lx//dXnRg8PmH1eXF0BgG3GqGFZ9C15265cCr4CRjBVlBpHH2PI5jLkzWRY3BGfcSQfBMxUJACISENQzkyVEXbmslXyrk8I+hyz1z6LPmCIDrYUVNvUbKZTxwhFLOIvqtEH9TK9yzTMoq9Ti9CrZNIcZ0lTW2F7PQgKN7wC0Bro80/5+e1vHjwLuFth8BgF6ubgu2ozlqUhy1rrXcZavD3PLhtdop8I3mYh9W9XlH9iXqgoFwXdXOEye3dyQ8w/vBx8/XPfcP8j11eBSObncp7//ejW4vL66HaiXr24/XJ8ZH9fF5b+uzi/Nux0OmynLeDqBSsar7Bb2a82H5S8zwpjma5hgPQ8ADTeJT7f/qAuVqhG8JleuX3C4xo06dnTBrgk9QvP984uJgB5wkEslozOeT4UCV4k66UCG7t21Hq+YhtbgdWihxdCRi1iu5+pRvGkvbqFCUqDh4BFcagq79ziKo0XfTGlCxwzaGvgqxz5fRb38cvl+QP758ezdJXiqeuT8w7ub66uz9+eXPXJxNjhz/ORK21x/OFca5d3Z+7NfLt9dvh/0yM2H66vzP/HRR6lsPn44e3f1/hdyfn0FKDoom8o17VKqRn+LrleQbJI/cenJMmUwfbQu6vW7lzRBdcFejzzXxNhHqJFBwG/Ma30gV/JjWVjdHFhrvBRzUNuLMTDL2CONS3Dv1/mMlWR59Hz7uVJDtpkRGq8stX7n30A2Dbt75UkJsb3UZ6O2MNcPvrIwj+UaLVjMpqzIZitojotLfX327sP7q8GHj1fvf+k5Tz9e3t58eH97abzcg8vry3eXg49/dtEFGRvfPbCV7sQhuiWHMT2w2QoGx/odTy5BvqjAKEGA2bPGKSGsMNL1Kz2fpKCGEVvEPhMjQJmHUWObiqiMV2Kt+pLAfmkDzmRNlGoK1pfjrb2+XL+0Lkivak2tWhXdnHSqRalUK6J8dvpUS+rUOqOQO6RKtaRJrciUxalRzWlRK6Kbnwplhqbv5baVnr5EpSfM23ZOWU38nW8jSgeNhoSln+TZs4Od8ljGCcuwi7Fvq3g/rWCh/OsjObu9vby9hTMKmCHkXx/Jx8t3lxdXZ11v26vkLb2V+IMYQbZHU4OP9p0kfGRrCo44/9elc24wbPIorMtl+Jjnd3koMlajYBQLWiyD//aWSEgdUdM0XdPi14jiykG7CaMtBrx2hHqLqSPWcUrgZ1jjwcUBC6URCqMIKpxvOUKAfFbqc+LZmiewHEU2a16Q/6wcFaTf8er9vy7fDz58/JNEIizBYZEH5BLgqJbELMm4PK/DfRJ8KEHJddYjAjpZRkQ8JcZy4JmzhJoWL9YXdmldev3ieG0MQAMPFi3hDVb28HWIRbmeZWOy5hahq5VZWBEhRoZUSiy0YW06aT8DOQLSWGEL0i0C84LGcXNrJpcgKVN+P/YVaWmbaUTRgnk9s16f8DasIl9DG9PGYuJiNJfRIq/0rFwn7ooctNHA07p+TrujdQuHs3wRsrXk4Dp5t4sxiqSxMNBSDdrdDoQoSeC0U6DtPatRpPKmdcS8+EOHItD9z+cC3KTCPgLS5jKgcQ/kSSiXYmUXdB8v3P6u3p9fXYAJarY91VUjn7jZbAhQd/wXeNsrbdQA4/V5bl7cwwMKHDZJwp4cCLBPmtfKNDLnGM1wnqFztEfSjAsZxSYyN2A7nNBk3G4Va+irbKgu+xZtnPq99dwBtTYUrJJjCYC+gnOJWC4UQoPSt2H5RDyBv8HsN6FIctF0OoT5Wk/+E4aBVIaOd6IHIEo9cgg0pZkYg3LqkWMSxiJvWpJafNZCmZXFxdOiJXUtiB3BX4h6g36BdqRfxDfQjj4vp1OazWrYK6GmC7AjlMXo1nhvZK877LVRRnnOogU0oAJ0ANaVXAf8MR+xcBbGphGugmvUvtLOFn1dFbpEyd+8X9oZs4A4TaCmR4LuGTVjN4Y7LlO/vBUXNFIHGQVclPl6CVSXG0M2ArdBM8ssCbJHR1JsggI6KljmEdBoL+Qsnbqmws27LhaCNQxw99dR5zQhItlLMzblOctbWiTf2HS6dzIWIING45koxxPZJfnj5e2AnN1cwTwCtliMc8ILkjNosFoIQsHyjYWK6cNG287htydr3PV02L48h2MmH0p1mTOzhcV0JspCxQoUEyP4zTGi8tz/wNKClAlcCQD72owOZG3zWmyZR5gAkqcsBHcoQg1+aF5pRojENC0LlgUSftMGUxelFvTtpzlJmBfgZ9C2E/TsE0fd+u+AdXNHjw7I130KwRBPnuvCN7ATCDkdc6jgyUi+ukY6EDRc1toCtWDeK0wNxGQcIkPjO3UiyEvZC+f5M4OAzJwgGu20X0AGDR8S8RSzaLwu7tCE0GjKE7jbpnDj6qLoRqK6iNiQH0ZDb0W7HssFoYGSLkx8MgoNGutUJfQCi5LatlilKi+Hd+s7RKj2zCzyncqaZvSSmeXTStSQjXmy1jrAmgRwJPA8L5tWUizGAQb6ztYwUQgJmKA3UihlJbKoZ9LWeqTI6GjEwx5JafjAip4sDdHzoOnZLBMQ+IZLAiBcbc/PJxs0Hu71yixWgiZPQ6HIWti2rpwNr/4AigtySFspkj9K0P+hShC0MKQ5R2JpkvxzMcwFLrNziPZiMsSs0eRjU9WpHG2+S/jTZAcGYSzKqIsNePnu7Oq6kqgIewNFUxCoaoFN4BxIyYixKA/kYCRNaOFAuEUsnhSS81v3N5Kzos3c0sNayt6yuKcsz+mYLTK2IGqxMb116flr4o3KhWJJaFRUhSxLCP6wFlLeKVh7VxdkwqgT6oJIeiZjiibjmJFhJnVCU5zHhnb5VjaY8oXPR1mrhGhGr4sgimxOBcQvFGiDRM2JtYGrvwBt1+dz5Z+wllEqEGhjgA+D89D60LLkkcUiZQhY2+J1xBlL49ldIdaH+iNA3BuIyqjrqNeJNGMhT6WpP4fJsorHZIp+fELalF0HfBBYkyNAG9SPwmUx1xWhSw8AqV6ozePBArqa7vMMhX4QUo2Kqcz+m6VrJqWYpUuTUlEErcqgIwnu4vdIaFABNVogfC2A4LtXJ40krbVKeVcGSaI2Xri8gRpNhdzx77St+0PbMmpB2rp5VzxHl+eu5yj4oXk9Gc6whgNpnRldQo1HzI1jk3eUxizrkSmNn2jGeqTM4jtb2co37enjHdx7ZiSKG67RtRv62VqokqcARqy85gQ7H0J5lKVPw8KGMiypohCS91s7dxeQrMmu0omG91lS8L13ir1BIzW148gaiGk6miCTkK4LhseBJpIyRvN1k6RgmoXoVZXysT+yLOJhsV70CNRIlZqkZgLMhctdrVjDMyn5FY8w6HmGVairmLUczKI4tceyi+sbc92TdzmPwQcNN/dVB7187xJ6A0PGmTycBz9UavnJa3tdO/CnHnLxkYGug1/0f+PVirv+DF/jNNDk/9CmIVo4OJgwb/jBD83rXOPi0SK7uwVRu9Osivor36dqhv+wWDIXIAa+amg98uvVL7/2yLvLi6tP73rk+sPvoPKv3v/zQ+fSDs90W+gho6Z6z56CH9qX6Lp8SQai51DyUtXa5wJ165pKCLgGpq+1b98NbmA6oBZGOxWqGPvz6dC51/Vqiz5VuiicyMjl+4ubD1fvB3Xi1Jnt+UTh2c9nTx2dOTut8xyWL0Qr687dyQrWz9Q/GF0jRloBS9BNJ0BwUYtkHaYXDsuB6FXTa6weW1fBLm2bPQgaOlEQrYk/xYo11YKNLm2VxboG2tyFW6XtHx8u/oSlezYYnJ3/CtkczVQ1S1CrFHXlmpEmFCMdsGXIbHMMR5njFr74qFIPIG047+QQvvhILv+lU1cuLz52MEd0gIAE56OUjmGekRRzZUoIKPAv7VtdwVEWGEA/tE93CzOroufRVTlY+eNpYSwPo8+Ws1fnF5/n8lO1DUCXDPrVVSIORuEABKQhZzQLJzVuSXBLcAwoDP63ZNns+ewKRTLi4xIKe0uISq+oARQTRtgjS4rgh/8/AGGeWaM="
 }
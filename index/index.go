@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package index
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/template"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+// Config configures the routing of events to per event type indices.
+type Config struct {
+	Enabled    bool   `config:"enabled"`
+	Format     string `config:"format"`
+	Namespace  string `config:"namespace"`
+	DataStream bool   `config:"data_stream"`
+	// Lifecycle maps event types, e.g. telemetry, to the ILM policy managing
+	// their indices.
+	Lifecycle map[string]string `config:"lifecycle"`
+	Template  TemplateConfig    `config:"template"`
+}
+
+// TemplateConfig configures the index templates generated for each event
+// type.
+type TemplateConfig struct {
+	Enabled   bool                      `config:"enabled"`
+	Overwrite bool                      `config:"overwrite"`
+	Settings  template.TemplateSettings `config:"settings"`
+}
+
+// DefaultConfig routes events to logs-symantec.<event_type>-default.
+var DefaultConfig = Config{
+	Format:    "logs-symantec.{event_type}-{namespace}",
+	Namespace: "default",
+	Template: TemplateConfig{
+		Enabled: true,
+	},
+}
+
+// Validate checks the format only references known placeholders.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Format == "" {
+		return fmt.Errorf("index.format must be set when index.enabled is true")
+	}
+	rest := strings.NewReplacer("{event_type}", "", "{namespace}", "").Replace(c.Format)
+	if strings.ContainsAny(rest, "{}") {
+		return fmt.Errorf("index.format '%s' contains unknown placeholders, only {event_type} and {namespace} are supported", c.Format)
+	}
+	if c.Format != strings.ToLower(c.Format) || strings.ToLower(c.Namespace) != c.Namespace {
+		return fmt.Errorf("index.format and index.namespace must be lowercase")
+	}
+	for name := range c.Lifecycle {
+		if !hasEventType(name) {
+			return fmt.Errorf("index.lifecycle references unknown event type '%s'", name)
+		}
+	}
+	return nil
+}
+
+// eventTypes returns the event types that have an index of their own, the
+// exported ones and the collected ones.
+func eventTypes() []client.EventType {
+	types := append([]client.EventType{}, client.AllTypes...)
+	return append(types, client.CollectedTypes...)
+}
+
+func hasEventType(name string) bool {
+	for _, t := range eventTypes() {
+		if t.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Router sets the index each event is published to from its event type.
+type Router struct {
+	config Config
+}
+
+// NewRouter creates a Router. A nil Router is returned when per event type
+// routing is disabled.
+func NewRouter(config Config) *Router {
+	if !config.Enabled {
+		return nil
+	}
+	return &Router{config: config}
+}
+
+// Name returns the index, or data stream, name of the given event type.
+func (r *Router) Name(t client.EventType) string {
+	return strings.NewReplacer(
+		"{event_type}", t.Name(),
+		"{namespace}", r.config.Namespace,
+	).Replace(r.config.Format)
+}
+
+// Apply sets the @metadata fields routing the event to the index of its
+// event type. Data streams are written to by name, indices get the daily
+// suffix libbeat appends to @metadata.index. The events of an unknown type,
+// which has no template, stay in the default index.
+func (r *Router) Apply(event *beat.Event, t client.EventType) {
+	if r == nil || t == client.UNKNOWN {
+		return
+	}
+	if event.Meta == nil {
+		event.Meta = common.MapStr{}
+	}
+
+	if !r.config.DataStream {
+		event.Meta["index"] = r.Name(t)
+		return
+	}
+
+	event.Meta["alias"] = r.Name(t)
+	event.Fields.Put("data_stream", common.MapStr{
+		"type":      "logs",
+		"dataset":   "symantec." + t.Name(),
+		"namespace": r.config.Namespace,
+	})
+}
@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+func TestRouterApply(t *testing.T) {
+	a := assert.New(t)
+
+	config := DefaultConfig
+	config.Enabled = true
+	r := NewRouter(config)
+	a.Equal("logs-symantec.malware_protection-default", r.Name(client.MALWARE_PROTECTION))
+	a.Equal("logs-symantec.agent_framework-default", r.Name(client.AGENT_FRAMEWORK))
+
+	event := beat.Event{Fields: common.MapStr{}}
+	r.Apply(&event, client.TELEMETRY)
+	a.Equal("logs-symantec.telemetry-default", event.Meta["index"])
+
+	// The streamed events of an unknown type have no template.
+	event = beat.Event{Fields: common.MapStr{}}
+	r.Apply(&event, client.UNKNOWN)
+	a.Nil(event.Meta)
+
+	config.DataStream = true
+	event = beat.Event{Fields: common.MapStr{}}
+	NewRouter(config).Apply(&event, client.TELEMETRY)
+	a.Equal("logs-symantec.telemetry-default", event.Meta["alias"])
+	dataset, _ := event.Fields.GetValue("data_stream.dataset")
+	a.Equal("symantec.telemetry", dataset)
+}
+
+func TestDisabledRouter(t *testing.T) {
+	event := beat.Event{Fields: common.MapStr{}}
+	NewRouter(DefaultConfig).Apply(&event, client.TELEMETRY)
+	assert.Nil(t, event.Meta)
+}
+
+func TestConfigValidate(t *testing.T) {
+	a := assert.New(t)
+
+	config := DefaultConfig
+	config.Enabled = true
+	a.NoError(config.Validate())
+
+	config.Format = "logs-symantec.{type}"
+	a.Error(config.Validate())
+
+	config = DefaultConfig
+	config.Enabled = true
+	config.Lifecycle = map[string]string{"telemetry": "7-days", "malware": "1-year"}
+	a.Error(config.Validate())
+
+	// The collected event types have their own index too.
+	config.Lifecycle = map[string]string{"telemetry": "7-days", "dlp_incident": "1-year"}
+	a.NoError(config.Validate())
+}
+
+func TestFieldsFor(t *testing.T) {
+	fields := `- key: ecs
+  fields: []
+- key: symantecbeat
+  fields: []
+- key: symantecbeat-objects
+  fields: []
+- key: symantecbeat-network
+  fields: []
+- key: symantecbeat-web
+  fields: []
+`
+	expected := `- key: ecs
+  fields: []
+- key: symantecbeat
+  fields: []
+- key: symantecbeat-objects
+  fields: []
+- key: symantecbeat-web
+  fields: []
+`
+	assert.Equal(t, expected, string(FieldsFor([]byte(fields), client.WEB_SECURITY)))
+}
+
+func TestFieldsForCollectedType(t *testing.T) {
+	fields := `- key: symantecbeat
+  fields: []
+- key: symantecbeat-sepm
+  fields: []
+- key: symantecbeat-web
+  fields: []
+- key: symantecbeat-edr
+  fields: []
+`
+	expected := `- key: symantecbeat
+  fields: []
+- key: symantecbeat-sepm
+  fields: []
+- key: symantecbeat-edr
+  fields: []
+`
+	assert.Equal(t, expected, string(FieldsFor([]byte(fields), client.EDR_INCIDENT)))
+}
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package index
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/template"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+// families maps the event types to the fields.yml section describing their
+// payload. Sections of the other families are left out of the template of an
+// event type.
+var families = map[client.EventType]string{
+	client.MALWARE_PROTECTION:    "symantecbeat-threat-protection",
+	client.BEHAVIORAL_ANALYSIS:   "symantecbeat-threat-protection",
+	client.EXPLOIT_PROTECTION:    "symantecbeat-threat-protection",
+	client.TAMPER_PROTECTION:     "symantecbeat-threat-protection",
+	client.TDAD_PROTECT:          "symantecbeat-threat-protection",
+	client.FIREWALL:              "symantecbeat-network",
+	client.NETWORK_IPS:           "symantecbeat-network",
+	client.NETWORK_INTEGRITY:     "symantecbeat-network",
+	client.DECEPTION:             "symantecbeat-network",
+	client.WEB_SECURITY:          "symantecbeat-web",
+	client.APP_CONTROL:           "symantecbeat-control",
+	client.APP_CONTROL_LITE:      "symantecbeat-control",
+	client.APP_CONTROL_WHITELIST: "symantecbeat-control",
+	client.APP_ISOLATION:         "symantecbeat-control",
+	client.DEVICE_CONTROL:        "symantecbeat-control",
+	client.AGENT_FRAMEWORK:       "symantecbeat-management",
+	client.COMPLIANCE:            "symantecbeat-management",
+	client.DATA_PROTECTION:       "symantecbeat-management",
+	client.LOCATION_MANAGEMENT:   "symantecbeat-management",
+	client.POLICY_MANAGER:        "symantecbeat-management",
+	client.ROAMING_CLIENT:        "symantecbeat-management",
+	client.DETECTION_MONITORING:  "symantecbeat-detection",
+	client.DETECTION_RESPONSE:    "symantecbeat-detection",
+	client.TELEMETRY:             "symantecbeat-detection",
+	client.VR_ASSESSMENT:         "symantecbeat-vulnerability",
+	client.VR_REMEDIATION:        "symantecbeat-vulnerability",
+	client.INCIDENT:              "symantecbeat-incidents",
+	client.EMAIL_SECURITY:        "symantecbeat-email",
+	client.DLP_INCIDENT:          "symantecbeat-dlp",
+	client.EDR_EVENT:             "symantecbeat-edr",
+	client.EDR_INCIDENT:          "symantecbeat-edr",
+}
+
+// sharedSections are kept in the template of every event type.
+var sharedSections = map[string]bool{
+	"symantecbeat-objects": true,
+	"symantecbeat-devices": true,
+	"symantecbeat-sepm":    true,
+}
+
+// ESClient is the subset of the Elasticsearch client API needed to load the
+// index templates.
+type ESClient interface {
+	LoadJSON(path string, json map[string]interface{}) ([]byte, error)
+	Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error)
+	GetVersion() common.Version
+}
+
+// LoadTemplates loads an index template, or an index template for data
+// streams, for every event type.
+func (r *Router) LoadTemplates(esClient ESClient, info beat.Info, fields []byte) error {
+	if r == nil || !r.config.Template.Enabled {
+		return nil
+	}
+
+	for _, t := range eventTypes() {
+		path, body, err := r.template(esClient.GetVersion(), info, fields, t)
+		if err != nil {
+			return fmt.Errorf("error building template for %s: %v", t.Name(), err)
+		}
+
+		if !r.config.Template.Overwrite {
+			status, _, _ := esClient.Request("GET", path, "", nil, nil)
+			if status == 200 {
+				logp.Debug("index", "Template %s already loaded", path)
+				continue
+			}
+		}
+
+		if resp, err := esClient.LoadJSON(path, body); err != nil {
+			return fmt.Errorf("error loading template %s: %v: %s", path, err, resp)
+		}
+		logp.Info("Elasticsearch template %s loaded", path)
+	}
+	return nil
+}
+
+func (r *Router) template(esVersion common.Version, info beat.Info, fields []byte, t client.EventType) (string, common.MapStr, error) {
+	name := r.Name(t)
+
+	config := template.DefaultConfig()
+	config.Name = name
+	config.Pattern = name + "*"
+	config.Settings.Source = r.config.Template.Settings.Source
+	config.Settings.Index = map[string]interface{}{}
+	for k, v := range r.config.Template.Settings.Index {
+		config.Settings.Index[k] = v
+	}
+	if policy, ok := r.config.Lifecycle[t.Name()]; ok {
+		config.Settings.Index["lifecycle.name"] = policy
+	}
+
+	tmpl, err := template.New(info.Version, info.Beat, esVersion, config, false)
+	if err != nil {
+		return "", nil, err
+	}
+	body, err := tmpl.LoadBytes(FieldsFor(fields, t))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !r.config.DataStream {
+		return "/_template/" + name, body, nil
+	}
+
+	// Data streams need a composable index template, available since
+	// Elasticsearch 7.8.
+	return "/_index_template/" + name, common.MapStr{
+		"index_patterns": body["index_patterns"],
+		"priority":       200,
+		"data_stream":    common.MapStr{},
+		"template": common.MapStr{
+			"settings": body["settings"],
+			"mappings": body["mappings"],
+		},
+	}, nil
+}
+
+// FieldsFor returns the fields.yml content with the payload sections of the
+// other event type families left out.
+func FieldsFor(fields []byte, t client.EventType) []byte {
+	family := families[t]
+
+	var out []string
+	keep := true
+	for _, line := range strings.SplitAfter(string(fields), "\n") {
+		if strings.HasPrefix(line, "- key: ") {
+			key := strings.TrimSpace(strings.TrimPrefix(line, "- key: "))
+			keep = !strings.HasPrefix(key, "symantecbeat-") || sharedSections[key] || key == family
+		}
+		if keep {
+			out = append(out, line)
+		}
+	}
+	return []byte(strings.Join(out, ""))
+}
@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/backoff"
+)
+
+// BackoffConfig configures the wait between the attempts of a collector,
+// e.g. to reconnect or to fetch a page again.
+type BackoffConfig struct {
+	Init time.Duration `config:"init"`
+	Max  time.Duration `config:"max"`
+}
+
+// DefaultBackoff waits from a second up to a minute.
+var DefaultBackoff = BackoffConfig{
+	Init: time.Second,
+	Max:  time.Minute,
+}
+
+// New creates a backoff with jitter, interrupted when done is closed.
+func (c BackoffConfig) New(done <-chan struct{}) backoff.Backoff {
+	return backoff.NewEqualJitterBackoff(done, c.Init, c.Max)
+}
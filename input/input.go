@@ -0,0 +1,227 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package input holds the registry of the collector types. Every source
+// registers a factory under its type name, e.g. sepm, and the beat creates a
+// collector for each configured input.
+package input
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// ErrStopped is returned by the page handlers of a collector to stop paging
+// once it is stopped.
+var ErrStopped = errors.New("collector stopped")
+
+// Collector collects the events of one source. Start starts collecting in
+// the background, sending the events and checkpoints of the source on
+// events, and Stop stops it and waits for it to return.
+type Collector interface {
+	Start(events chan<- Event)
+	Stop()
+}
+
+// OnceCollector is a Collector that can also collect once, from its
+// checkpoints up to end, and return. It is what the run once mode runs.
+type OnceCollector interface {
+	Collector
+	RunOnce(out Outlet, end time.Time) error
+}
+
+// Event is what a collector sends to its runner: an event to publish,
+// checkpoints, or both.
+type Event struct {
+	// Event is published when it is set.
+	Event *beat.Event
+	// Checkpoints are applied to the registry of the input once Event, and
+	// every event sent before it, are acknowledged by the output.
+	Checkpoints []Checkpoint
+}
+
+// Checkpoint is a change of the registry of an input. Value is stored under
+// Key, or Key is deleted when Value is nil. Value is encoded when the
+// checkpoint is applied, so it must not be modified once sent.
+type Checkpoint struct {
+	Key   string
+	Value interface{}
+}
+
+// CanRunOnce tells whether c, or every collector of a Group, is a
+// OnceCollector.
+func CanRunOnce(c Collector) bool {
+	if g, ok := c.(Group); ok {
+		for _, member := range g {
+			if !CanRunOnce(member) {
+				return false
+			}
+		}
+		return true
+	}
+	_, ok := c.(OnceCollector)
+	return ok
+}
+
+// Devices is refreshed by the devices inputs. The beat enriches the SES
+// events with the devices it holds.
+type Devices interface {
+	Update(devices []client.Device)
+}
+
+// Context is what the beat shares with the collectors it creates.
+type Context struct {
+	// ID identifies the input and names its registry.
+	ID string
+	// Store keeps the checkpoints of the input across restarts.
+	Store *registry.Store
+
+	// Tenant namespaces the ids, and so the registries, of the inputs of a
+	// tenant.
+	Tenant string
+	// Fields and Tags are added to every event of the input.
+	Fields common.MapStr
+	Tags   []string
+
+	// Client is configured with the SES credentials. Collectors work on
+	// their own copy.
+	Client  client.SymantecClient
+	Devices Devices
+	// Build turns events in the SES layout into published events, with
+	// their ingest pipeline and index.
+	Build client.EventBuilder
+
+	KeySanitization  client.KeySanitization
+	PreserveOriginal bool
+}
+
+// Factory creates the collector of an input from its configuration.
+type Factory func(cfg *common.Config, ctx Context) (Collector, error)
+
+var (
+	factoriesMutex sync.RWMutex
+	factories      = map[string]Factory{}
+	settings       = map[string]interface{}{}
+)
+
+// Register registers the factory of an input type. It is meant to be called
+// from the init function of the package implementing the type.
+func Register(name string, factory Factory) error {
+	factoriesMutex.Lock()
+	defer factoriesMutex.Unlock()
+
+	if name == "" {
+		return fmt.Errorf("error registering input: name cannot be empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("error registering input '%v': factory cannot be empty", name)
+	}
+	if _, exists := factories[name]; exists {
+		return fmt.Errorf("error registering input '%v': already registered", name)
+	}
+	factories[name] = factory
+	return nil
+}
+
+// GetFactory returns the factory of an input type.
+func GetFactory(name string) (Factory, error) {
+	factoriesMutex.RLock()
+	defer factoriesMutex.RUnlock()
+
+	factory, exists := factories[name]
+	if !exists {
+		return nil, fmt.Errorf("input type %v does not exist", name)
+	}
+	return factory, nil
+}
+
+// RegisterSettings registers the default configuration the factory of an
+// input type unpacks, so that its unknown and invalid settings are rejected
+// as the configuration is loaded. Like Register, it is meant to be called from an
+// init function.
+func RegisterSettings(name string, config interface{}) error {
+	factoriesMutex.Lock()
+	defer factoriesMutex.Unlock()
+
+	if _, exists := factories[name]; !exists {
+		return fmt.Errorf("error registering the settings of input '%v': input not registered", name)
+	}
+	if config == nil {
+		return fmt.Errorf("error registering the settings of input '%v': config cannot be empty", name)
+	}
+	settings[name] = config
+	return nil
+}
+
+// GetSettings returns the default configuration of an input type, nil when
+// none was registered.
+func GetSettings(name string) interface{} {
+	factoriesMutex.RLock()
+	defer factoriesMutex.RUnlock()
+
+	return settings[name]
+}
+
+// Group runs several collectors as one, e.g. one per EDR appliance.
+type Group []Collector
+
+// Start starts every collector of the group.
+func (g Group) Start(events chan<- Event) {
+	for _, c := range g {
+		c.Start(events)
+	}
+}
+
+// Stop stops every collector of the group and waits for them.
+func (g Group) Stop() {
+	var wg sync.WaitGroup
+	for _, c := range g {
+		wg.Add(1)
+		go func(c Collector) {
+			defer wg.Done()
+			c.Stop()
+		}(c)
+	}
+	wg.Wait()
+}
+
+// RunOnce runs every collector of the group once, one after the other, until
+// out is done. It returns the last error of the collectors.
+func (g Group) RunOnce(out Outlet, end time.Time) error {
+	var err error
+	for _, c := range g {
+		once, ok := c.(OnceCollector)
+		if !ok {
+			return fmt.Errorf("collector %T cannot run once", c)
+		}
+		if out.Stopped() {
+			return err
+		}
+		if collectErr := once.RunOnce(out, end); collectErr != nil {
+			err = collectErr
+		}
+	}
+	return err
+}
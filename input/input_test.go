@@ -0,0 +1,360 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package input
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// publisher keeps the published events until they are acknowledged. When
+// block is set, Publish blocks until Close.
+type publisher struct {
+	mutex  sync.Mutex
+	events []beat.Event
+	closed chan struct{}
+	block  bool
+}
+
+func (p *publisher) Publish(event beat.Event) {
+	if p.block {
+		<-p.closed
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.events = append(p.events, event)
+}
+
+func (p *publisher) PublishAll(events []beat.Event) {
+	for _, e := range events {
+		p.Publish(e)
+	}
+}
+
+func (p *publisher) Close() error {
+	select {
+	case <-p.closed:
+	default:
+		close(p.closed)
+	}
+	return nil
+}
+
+func (p *publisher) isClosed() bool {
+	select {
+	case <-p.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+type pipeline struct {
+	publisher *publisher
+	config    beat.ClientConfig
+	block     bool
+}
+
+func (p *pipeline) ConnectWith(config beat.ClientConfig) (beat.Client, error) {
+	p.config = config
+	p.publisher = &publisher{closed: make(chan struct{}), block: p.block}
+	return p.publisher, nil
+}
+func (p *pipeline) Connect() (beat.Client, error) { return p.ConnectWith(beat.ClientConfig{}) }
+
+// ack acknowledges the first n events not acknowledged yet.
+func (p *pipeline) ack(n int) {
+	p.publisher.mutex.Lock()
+	var data []interface{}
+	for _, e := range p.publisher.events[:n] {
+		data = append(data, e.Private)
+	}
+	p.publisher.events = p.publisher.events[n:]
+	p.publisher.mutex.Unlock()
+	p.config.ACKEvents(data)
+}
+
+// published waits for n events to be published.
+func (p *pipeline) published(n int) bool {
+	for i := 0; i < 100; i++ {
+		p.publisher.mutex.Lock()
+		published := len(p.publisher.events)
+		p.publisher.mutex.Unlock()
+		if published >= n {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+type collector struct {
+	ctx     Context
+	started bool
+	stopped bool
+}
+
+func (c *collector) Start(events chan<- Event) { c.started = true }
+func (c *collector) Stop()                     { c.stopped = true }
+
+func TestRegister(t *testing.T) {
+	factory := func(cfg *common.Config, ctx Context) (Collector, error) { return &collector{}, nil }
+	assert.NoError(t, Register("test_register", factory))
+	assert.Error(t, Register("test_register", factory))
+	assert.Error(t, Register("", factory))
+	assert.Error(t, Register("test_nil", nil))
+
+	_, err := GetFactory("test_register")
+	assert.NoError(t, err)
+	_, err = GetFactory("test_unknown")
+	assert.Error(t, err)
+
+	assert.Error(t, RegisterSettings("test_unknown", Config{}))
+	assert.Error(t, RegisterSettings("test_register", nil))
+	assert.NoError(t, RegisterSettings("test_register", Config{}))
+	assert.Equal(t, Config{}, GetSettings("test_register"))
+	assert.Nil(t, GetSettings("test_unknown"))
+}
+
+func TestNew(t *testing.T) {
+	a := assert.New(t)
+
+	var created *collector
+	factory := func(cfg *common.Config, ctx Context) (Collector, error) {
+		created = &collector{ctx: ctx}
+		return created, nil
+	}
+	a.NoError(Register("test_new", factory))
+
+	runner, err := New(common.MustNewConfigFrom(common.MapStr{"type": "test_new", "enabled": false}), Context{})
+	a.NoError(err)
+	a.Nil(runner)
+
+	_, err = New(common.MustNewConfigFrom(common.MapStr{"type": "test_unknown"}), Context{})
+	a.Error(err)
+	_, err = New(common.MustNewConfigFrom(common.MapStr{"id": "test"}), Context{})
+	a.Error(err)
+	_, err = New(common.MustNewConfigFrom(common.MapStr{"type": "test_new", "id": "../test"}), Context{})
+	a.Error(err)
+
+	runner, err = New(common.MustNewConfigFrom(common.MapStr{"type": "test_new"}), Context{})
+	a.NoError(err)
+	a.Equal("test_new", runner.ID())
+	a.Equal("test_new", created.ctx.ID)
+	a.NotNil(created.ctx.Store)
+
+	runner, err = New(common.MustNewConfigFrom(common.MapStr{"type": "test_new", "id": "second"}), Context{})
+	a.NoError(err)
+	a.Equal("second", runner.ID())
+
+	p := &pipeline{}
+	a.NoError(runner.Start(p))
+	runner.Stop()
+	a.True(created.started)
+	a.True(created.stopped)
+	a.True(p.publisher.isClosed())
+
+	ctx := Context{
+		Tenant: "acme",
+		Fields: common.MapStr{"organization": common.MapStr{"id": "acme"}},
+		Tags:   []string{"customer"},
+	}
+	runner, err = New(common.MustNewConfigFrom(common.MapStr{"type": "test_new"}), ctx)
+	a.NoError(err)
+	a.Equal("acme/test_new", runner.ID())
+	a.Equal("acme/test_new", created.ctx.ID)
+
+	a.NoError(runner.Start(p))
+	runner.Stop()
+	a.Equal(ctx.Fields, p.config.Processing.Fields)
+	a.Equal(ctx.Tags, p.config.Processing.EventMetadata.Tags)
+}
+
+func TestCheckpoints(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "input")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoints.json")
+	store, err := registry.OpenFile(path)
+	a.NoError(err)
+	checkpoint := func() int {
+		saved, err := registry.OpenFile(path)
+		a.NoError(err)
+		var checkpoint int
+		saved.Get("checkpoint", &checkpoint)
+		return checkpoint
+	}
+
+	sent := make(chan struct{})
+	runner := NewRunner("checkpoints", NewLoop(func(out Outlet) {
+		// Without pending events, the checkpoint is applied right away.
+		out.Checkpoint(Checkpoint{Key: "checkpoint", Value: 1})
+		out.Publish(beat.Event{})
+		out.Checkpoint(Checkpoint{Key: "checkpoint", Value: 2})
+		out.Publish(beat.Event{})
+		out.Checkpoint(Checkpoint{Key: "checkpoint", Value: 3}, Checkpoint{Key: "other", Value: 1})
+		out.Checkpoint(Checkpoint{Key: "other"})
+		out.Publish(beat.Event{})
+		close(sent)
+		<-out.Done()
+	}))
+	runner.store = store
+
+	p := &pipeline{}
+	a.NoError(runner.Start(p))
+	<-sent
+	a.True(p.published(3))
+	a.Equal(1, checkpoint())
+
+	// The checkpoints sent after an event wait for it to be acknowledged.
+	p.ack(1)
+	a.Equal(2, checkpoint())
+	p.ack(1)
+	a.Equal(3, checkpoint())
+	a.Equal([]string{"checkpoint"}, store.Keys())
+	runner.Stop()
+}
+
+func TestStopUnblocksPublish(t *testing.T) {
+	runner := NewRunner("blocked", NewLoop(func(out Outlet) {
+		for out.Publish(beat.Event{}) {
+		}
+	}))
+	p := &pipeline{block: true}
+	assert.NoError(t, runner.Start(p))
+
+	stopped := make(chan struct{})
+	go func() {
+		runner.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop is blocked by Publish")
+	}
+}
+
+// onceCollector publishes events and sends end as its checkpoint.
+type onceCollector struct {
+	collector
+	events    int
+	err       error
+	published chan struct{}
+}
+
+func (c *onceCollector) RunOnce(out Outlet, end time.Time) error {
+	for i := 0; i < c.events; i++ {
+		out.Publish(beat.Event{})
+	}
+	out.Checkpoint(Checkpoint{Key: "checkpoint", Value: end})
+	close(c.published)
+	return c.err
+}
+
+func TestCanRunOnce(t *testing.T) {
+	once := &onceCollector{}
+	assert.True(t, CanRunOnce(once))
+	assert.False(t, CanRunOnce(&collector{}))
+	assert.True(t, CanRunOnce(Group{once, once}))
+	assert.False(t, CanRunOnce(Group{once, &collector{}}))
+}
+
+func TestRunOnce(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "input")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "once.json")
+	store, err := registry.OpenFile(path)
+	a.NoError(err)
+
+	newRunner := func(err error) (*Runner, *onceCollector) {
+		c := &onceCollector{events: 2, err: err, published: make(chan struct{})}
+		runner := NewRunner("once", c)
+		runner.store = store
+		return runner, c
+	}
+	end := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	checkpoint := func() (time.Time, bool) {
+		saved, err := registry.OpenFile(path)
+		a.NoError(err)
+		var checkpoint time.Time
+		found, err := saved.Get("checkpoint", &checkpoint)
+		a.NoError(err)
+		return checkpoint, found
+	}
+
+	// The checkpoint is not saved when the events are not acknowledged.
+	runner, c := newRunner(nil)
+	p := &pipeline{}
+	result := make(chan error)
+	go func() { result <- runner.RunOnce(p, end) }()
+	<-c.published
+	p.ack(1)
+	runner.Stop()
+	a.Error(<-result)
+	_, found := checkpoint()
+	a.False(found)
+
+	// It is saved once they are all acknowledged.
+	runner, c = newRunner(nil)
+	go func() { result <- runner.RunOnce(p, end) }()
+	<-c.published
+	_, found = checkpoint()
+	a.False(found)
+	p.ack(2)
+	a.NoError(<-result)
+	a.True(p.publisher.isClosed())
+	saved, found := checkpoint()
+	a.True(found)
+	a.True(end.Equal(saved))
+
+	// The errors of the collector are returned after saving.
+	runner, c = newRunner(errors.New("export failed"))
+	go func() { result <- runner.RunOnce(p, end.Add(time.Hour)) }()
+	<-c.published
+	p.ack(2)
+	err = <-result
+	if a.Error(err) {
+		a.Contains(err.Error(), "input once: export failed")
+	}
+	saved, _ = checkpoint()
+	a.True(end.Add(time.Hour).Equal(saved))
+
+	err = NewRunner("daemon", &collector{}).RunOnce(p, end)
+	a.Error(err)
+}
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inputtest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// NewStore opens a registry store in a new temporary directory. The
+// returned function removes the directory.
+func NewStore(t testing.TB, name string) (*registry.Store, func()) {
+	dir, err := ioutil.TempDir("", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := registry.OpenFile(filepath.Join(dir, name+".json"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return store, func() { os.RemoveAll(dir) }
+}
+
+// NewSESServer starts a fake SES API, serving a token to the returned client
+// and the other requests with handler.
+func NewSESServer(handler http.HandlerFunc) (*httptest.Server, client.SymantecClient) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/tokens" {
+			fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+			return
+		}
+		handler(w, r)
+	}))
+	return server, client.NewSymantecClient(server.URL, "", "", "id", "secret")
+}
+
+// BasicAuth serves the requests with the given credentials with handler, and
+// rejects the others like the on-premises servers do.
+func BasicAuth(username, password string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != username || pass != password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// Build is the event builder of the tests. It sets the name of the event type
+// as the pipeline of the event, telling which type the event was built with.
+func Build(t client.EventType, fields common.MapStr) beat.Event {
+	return beat.Event{
+		Timestamp: time.Now(),
+		Meta:      common.MapStr{"pipeline": t.Name()},
+		Fields:    fields,
+	}
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package inputtest helps testing the collectors.
+package inputtest
+
+import (
+	"sync"
+
+	"github.com/elastic/beats/libbeat/beat"
+
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// Outlet keeps the events published by a collector. The checkpoints are
+// applied to Store right away, as if the output acknowledged the events as
+// soon as they are published.
+type Outlet struct {
+	Store *registry.Store
+
+	mutex  sync.Mutex
+	Events []beat.Event
+	done   chan struct{}
+}
+
+// NewOutlet creates an Outlet applying the checkpoints to store, which may be
+// nil.
+func NewOutlet(store *registry.Store) *Outlet {
+	return &Outlet{
+		Store: store,
+		done:  make(chan struct{}),
+	}
+}
+
+// Publish keeps event.
+func (o *Outlet) Publish(event beat.Event) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.Events = append(o.Events, event)
+	return !o.Stopped()
+}
+
+// Checkpoint applies the checkpoints to the store and saves it.
+func (o *Outlet) Checkpoint(checkpoints ...input.Checkpoint) bool {
+	if o.Store == nil {
+		return !o.Stopped()
+	}
+	for _, cp := range checkpoints {
+		if cp.Value == nil {
+			o.Store.Delete(cp.Key)
+			continue
+		}
+		if err := o.Store.Set(cp.Key, cp.Value); err != nil {
+			panic(err)
+		}
+	}
+	if err := o.Store.Save(); err != nil {
+		panic(err)
+	}
+	return !o.Stopped()
+}
+
+// Done is closed by Stop.
+func (o *Outlet) Done() <-chan struct{} {
+	return o.done
+}
+
+// Stopped tells whether Stop was called.
+func (o *Outlet) Stopped() bool {
+	select {
+	case <-o.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Stop stops the collector using the outlet.
+func (o *Outlet) Stop() {
+	close(o.done)
+}
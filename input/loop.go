@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// Loop is a Collector running a function in its own goroutine until Stop,
+// e.g. a listener or a long-polling loop.
+type Loop struct {
+	run  func(out Outlet)
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewLoop creates the Loop running run. run returns once out is done.
+func NewLoop(run func(out Outlet)) *Loop {
+	return &Loop{
+		run:  run,
+		done: make(chan struct{}),
+	}
+}
+
+// Start runs the function of the loop, sending on events.
+func (l *Loop) Start(events chan<- Event) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		l.run(NewOutlet(events, l.done))
+	}()
+}
+
+// Stop stops the function and waits for it to return.
+func (l *Loop) Stop() {
+	close(l.done)
+	l.wg.Wait()
+}
+
+// Poller collects from its checkpoints up to end. It returns early when out
+// is done.
+type Poller interface {
+	Collect(out Outlet, end time.Time) error
+}
+
+// Periodic is the Collector of a Poller. It collects right away and then
+// every period, up to the current time, and collects once up to the end of
+// the run once mode.
+type Periodic struct {
+	*Loop
+	poller Poller
+}
+
+// NewPeriodic creates the Periodic collector of poller, logging the errors of
+// the collections with logger.
+func NewPeriodic(period time.Duration, poller Poller, logger *logp.Logger) *Periodic {
+	return &Periodic{
+		Loop: NewLoop(func(out Outlet) {
+			ticker := time.NewTicker(period)
+			defer ticker.Stop()
+
+			for {
+				if err := poller.Collect(out, time.Now().UTC()); err != nil {
+					logger.Errorf("Error collecting err=%s", err.Error())
+				}
+
+				select {
+				case <-out.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}),
+		poller: poller,
+	}
+}
+
+// RunOnce collects up to end.
+func (p *Periodic) RunOnce(out Outlet, end time.Time) error {
+	return p.poller.Collect(out, end)
+}
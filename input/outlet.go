@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+)
+
+// Outlet is what a collector sends its events and checkpoints to. Both
+// Publish and Checkpoint block until the event is taken, and return false
+// once the collector is stopped.
+type Outlet interface {
+	Publish(event beat.Event) bool
+	// Checkpoint sends checkpoints to apply once the events published before
+	// are acknowledged.
+	Checkpoint(checkpoints ...Checkpoint) bool
+	// Done is closed when the collector is stopped.
+	Done() <-chan struct{}
+	// Stopped tells whether Done is closed.
+	Stopped() bool
+}
+
+type outlet struct {
+	events chan<- Event
+	done   <-chan struct{}
+}
+
+// NewOutlet returns the Outlet sending on events until done is closed.
+func NewOutlet(events chan<- Event, done <-chan struct{}) Outlet {
+	return &outlet{events: events, done: done}
+}
+
+func (o *outlet) Publish(event beat.Event) bool {
+	return o.send(Event{Event: &event})
+}
+
+func (o *outlet) Checkpoint(checkpoints ...Checkpoint) bool {
+	return o.send(Event{Checkpoints: checkpoints})
+}
+
+func (o *outlet) send(e Event) bool {
+	select {
+	case <-o.done:
+		return false
+	default:
+	}
+
+	select {
+	case <-o.done:
+		return false
+	case o.events <- e:
+		return true
+	}
+}
+
+func (o *outlet) Done() <-chan struct{} {
+	return o.done
+}
+
+func (o *outlet) Stopped() bool {
+	select {
+	case <-o.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,364 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// Config holds the settings shared by every input.
+type Config struct {
+	Type string `config:"type"`
+	// ID names the registry of the input. It defaults to the type and must
+	// be set when several inputs have the same type.
+	ID      string `config:"id"`
+	Enabled bool   `config:"enabled"`
+}
+
+// DefaultConfig enables the configured inputs.
+var DefaultConfig = Config{
+	Enabled: true,
+}
+
+// Validate checks that the input has a type, and an id that can name its
+// registry.
+func (c *Config) Validate() error {
+	if c.Type == "" {
+		return errors.New("every input needs a type")
+	}
+	if c.ID != "" {
+		return registry.CheckID(c.ID)
+	}
+	return nil
+}
+
+// Runner runs the collector of an input. It publishes the events the
+// collector sends, and applies its checkpoints to the registry of the input
+// once the output acknowledged the events sent before them.
+type Runner struct {
+	id        string
+	collector Collector
+	// store is the registry of the input.
+	store *registry.Store
+	// processing adds the static fields and tags of the input.
+	processing beat.ProcessingConfig
+	done       chan struct{}
+	// publisher is the client of the pipeline, closed by Stop to unblock
+	// Publish.
+	mutex     sync.Mutex
+	publisher beat.Client
+	wg        sync.WaitGroup
+	logger    *logp.Logger
+}
+
+// New creates the runner of the input configured by cfg, or returns nil when
+// the input is disabled. ctx holds what the beat shares with every input; its
+// ID and Store are set from the input configuration.
+func New(cfg *common.Config, ctx Context) (*Runner, error) {
+	config := DefaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	if !config.Enabled {
+		return nil, nil
+	}
+	factory, err := GetFactory(config.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.ID = config.ID
+	if ctx.ID == "" {
+		ctx.ID = config.Type
+	}
+	if ctx.Tenant != "" {
+		ctx.ID = ctx.Tenant + "/" + ctx.ID
+	}
+	ctx.Store, err = registry.Open(ctx.ID)
+	if err != nil {
+		return nil, err
+	}
+	collector, err := factory(cfg, ctx)
+	if err != nil {
+		return nil, err
+	}
+	runner := NewRunner(ctx.ID, collector)
+	runner.store = ctx.Store
+	runner.processing = beat.ProcessingConfig{
+		Fields:        ctx.Fields,
+		EventMetadata: common.EventMetadata{Tags: ctx.Tags},
+	}
+	return runner, nil
+}
+
+// NewRunner creates the runner of a collector.
+func NewRunner(id string, collector Collector) *Runner {
+	return &Runner{
+		id:        id,
+		collector: collector,
+		done:      make(chan struct{}),
+		logger:    logp.NewLogger("input"),
+	}
+}
+
+// ID returns the id of the input.
+func (r *Runner) ID() string {
+	return r.id
+}
+
+func (r *Runner) connect(pipeline beat.PipelineConnector, acker *acker) (beat.Client, error) {
+	publisher, err := pipeline.ConnectWith(beat.ClientConfig{
+		Processing: r.processing,
+		ACKEvents:  acker.ack,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mutex.Lock()
+	r.publisher = publisher
+	r.mutex.Unlock()
+	return publisher, nil
+}
+
+// Start connects the input to the pipeline and starts its collector.
+func (r *Runner) Start(pipeline beat.PipelineConnector) error {
+	acker := newACKer(r.store, r.logger)
+	publisher, err := r.connect(pipeline, acker)
+	if err != nil {
+		return err
+	}
+
+	r.logger.Infof("Starting input %s", r.id)
+	events := make(chan Event)
+	r.collector.Start(events)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		for {
+			select {
+			case <-r.done:
+				return
+			case e := <-events:
+				acker.send(publisher, e)
+			}
+		}
+	}()
+	return nil
+}
+
+// CanRunOnce tells whether the collector of the input can run once.
+func (r *Runner) CanRunOnce() bool {
+	return CanRunOnce(r.collector)
+}
+
+// RunOnce collects up to end and waits for the pipeline to acknowledge the
+// events, or for Stop. The registry of the input is held until then, so the
+// events of an interrupted run are collected again by the next one.
+func (r *Runner) RunOnce(pipeline beat.PipelineConnector, end time.Time) error {
+	collector, ok := r.collector.(OnceCollector)
+	if !ok {
+		return fmt.Errorf("input %s cannot run once", r.id)
+	}
+
+	acker := newACKer(r.store, r.logger)
+	publisher, err := r.connect(pipeline, acker)
+	if err != nil {
+		return err
+	}
+	defer publisher.Close()
+
+	r.wg.Add(1)
+	defer r.wg.Done()
+	if r.store != nil {
+		r.store.Hold()
+	}
+
+	r.logger.Infof("Running input %s once up to %s", r.id, end.Format(time.RFC3339))
+	events := make(chan Event)
+	result := make(chan error, 1)
+	go func() {
+		result <- collector.RunOnce(NewOutlet(events, r.done), end)
+	}()
+	var collectErr error
+	for collecting := true; collecting; {
+		select {
+		case e := <-events:
+			acker.send(publisher, e)
+		case collectErr = <-result:
+			collecting = false
+		}
+	}
+
+	if !acker.wait(r.done) {
+		return fmt.Errorf("input %s stopped before its events were acknowledged", r.id)
+	}
+	if r.store != nil {
+		if err := r.store.Release(); err != nil {
+			return err
+		}
+	}
+	if collectErr != nil {
+		return fmt.Errorf("input %s: %v", r.id, collectErr)
+	}
+	r.logger.Infof("Input %s published %d events", r.id, acker.published)
+	return nil
+}
+
+// Stop stops the collector and waits for it to return. The client is closed
+// first, so a Publish blocked by the pipeline returns.
+func (r *Runner) Stop() {
+	close(r.done)
+	r.mutex.Lock()
+	if r.publisher != nil {
+		r.publisher.Close()
+	}
+	r.mutex.Unlock()
+	r.collector.Stop()
+	r.wg.Wait()
+	r.logger.Infof("Stopped input %s", r.id)
+}
+
+// acker publishes the events of an input and applies its checkpoints once
+// the events sent before them are acknowledged.
+type acker struct {
+	store  *registry.Store
+	logger *logp.Logger
+
+	mutex     sync.Mutex
+	published int
+	acked     int
+	// last is the private data of the last published event. The checkpoints
+	// sent after it wait for it to be acknowledged.
+	last *pending
+	// acks is signaled on every acknowledgement.
+	acks chan struct{}
+}
+
+// pending is the private data of a published event.
+type pending struct {
+	checkpoints []Checkpoint
+	acked       bool
+}
+
+func newACKer(store *registry.Store, logger *logp.Logger) *acker {
+	return &acker{
+		store:  store,
+		logger: logger,
+		acks:   make(chan struct{}, 1),
+	}
+}
+
+// send publishes the event of e, or applies its checkpoints right away when
+// there is no event waiting for an acknowledgement.
+func (a *acker) send(publisher beat.Client, e Event) {
+	a.mutex.Lock()
+	if e.Event == nil {
+		if a.last != nil && !a.last.acked {
+			a.last.checkpoints = append(a.last.checkpoints, e.Checkpoints...)
+			a.mutex.Unlock()
+			return
+		}
+		a.apply(e.Checkpoints)
+		a.mutex.Unlock()
+		a.save()
+		return
+	}
+
+	p := &pending{checkpoints: e.Checkpoints}
+	a.published++
+	a.last = p
+	a.mutex.Unlock()
+
+	event := *e.Event
+	event.Private = p
+	publisher.Publish(event)
+}
+
+// ack is the ACKEvents callback of the client. The events are acknowledged
+// in the order they were published.
+func (a *acker) ack(data []interface{}) {
+	a.mutex.Lock()
+	for _, d := range data {
+		a.acked++
+		if p, ok := d.(*pending); ok {
+			p.acked = true
+			a.apply(p.checkpoints)
+		}
+	}
+	a.mutex.Unlock()
+	a.save()
+
+	select {
+	case a.acks <- struct{}{}:
+	default:
+	}
+}
+
+// apply changes the registry. It is called with the mutex held, so the
+// checkpoints are applied in the order they were sent.
+func (a *acker) apply(checkpoints []Checkpoint) {
+	if a.store == nil {
+		return
+	}
+	for _, cp := range checkpoints {
+		if cp.Value == nil {
+			a.store.Delete(cp.Key)
+			continue
+		}
+		if err := a.store.Set(cp.Key, cp.Value); err != nil {
+			a.logger.Errorf("Error storing checkpoint %s err=%s", cp.Key, err.Error())
+		}
+	}
+}
+
+func (a *acker) save() {
+	if a.store == nil {
+		return
+	}
+	if err := a.store.Save(); err != nil {
+		a.logger.Errorf("Error saving the registry err=%s", err.Error())
+	}
+}
+
+// wait waits for the published events to be acknowledged. It returns false
+// when done is closed first.
+func (a *acker) wait(done <-chan struct{}) bool {
+	for {
+		a.mutex.Lock()
+		acked := a.acked >= a.published
+		a.mutex.Unlock()
+		if acked {
+			return true
+		}
+
+		select {
+		case <-done:
+			return false
+		case <-a.acks:
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inventory
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+)
+
+// EventType is the event_type of the device inventory snapshot documents.
+const EventType = "DEVICE INVENTORY"
+
+// Config configures the device inventory collector.
+type Config struct {
+	Enabled   bool          `config:"enabled"`
+	Period    time.Duration `config:"period"`
+	BatchSize int           `config:"batch_size"`
+	Publish   bool          `config:"publish"`
+	Enrich    bool          `config:"enrich"`
+}
+
+// DefaultConfig refreshes the inventory every hour.
+var DefaultConfig = Config{
+	Period:    time.Hour,
+	BatchSize: 100,
+	Publish:   true,
+	Enrich:    true,
+}
+
+// Validate checks the period and the page size of the inventory requests.
+func (c *Config) Validate() error {
+	if c.Period <= 0 {
+		return fmt.Errorf("devices.period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > client.MaxBatchSize {
+		return fmt.Errorf("devices.batch_size must be between 1 and %d, got %d", client.MaxBatchSize, c.BatchSize)
+	}
+	return nil
+}
+
+// Cache holds the last fetched device inventory keyed by device uid.
+type Cache struct {
+	mutex   sync.RWMutex
+	devices map[string]client.Device
+}
+
+// NewCache creates an empty Cache.
+func NewCache() *Cache {
+	return &Cache{devices: map[string]client.Device{}}
+}
+
+// Update replaces the cached inventory.
+func (c *Cache) Update(devices []client.Device) {
+	m := make(map[string]client.Device, len(devices))
+	for _, d := range devices {
+		m[d.UID] = d
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.devices = m
+}
+
+// Get returns the cached device with the given uid.
+func (c *Cache) Get(uid string) (client.Device, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	d, ok := c.devices[uid]
+	return d, ok
+}
+
+// Enrich adds the group, OS and owner of the device that reported the event
+// under device_inventory. Events of unknown devices are left untouched.
+func (c *Cache) Enrich(fields common.MapStr) {
+	if c == nil {
+		return
+	}
+	uid, ok := fields["device_uid"].(string)
+	if !ok {
+		return
+	}
+	d, ok := c.Get(uid)
+	if !ok {
+		return
+	}
+
+	fields.Put("device_inventory", common.MapStr{
+		"group": common.MapStr{
+			"id":   d.DeviceGroupID,
+			"name": d.DeviceGroupName,
+		},
+		"os": common.MapStr{
+			"name":    d.OS.Name,
+			"version": d.OS.Version,
+		},
+		"owner": d.Owner,
+	})
+}
+
+// Collector periodically fetches the device inventory, refreshes the cache
+// and publishes a snapshot document for every device.
+type Collector struct {
+	config   Config
+	smClient client.SymantecClient
+	devices  input.Devices
+	build    client.EventBuilder
+	logger   *logp.Logger
+}
+
+// NewCollector creates a Collector. It works on its own copy of the SES client
+// so its token does not interfere with the event collection.
+func NewCollector(config Config, smClient client.SymantecClient, devices input.Devices, build client.EventBuilder) *Collector {
+	return &Collector{
+		config:   config,
+		smClient: smClient,
+		devices:  devices,
+		build:    build,
+		logger:   logp.NewLogger("inventory"),
+	}
+}
+
+// Collect refreshes the device inventory. It has no checkpoint, so end is
+// ignored.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	if err := c.smClient.GetOauthToken(); err != nil {
+		return err
+	}
+	devices, err := c.smClient.GetDevices(c.config.BatchSize)
+	if err != nil {
+		return err
+	}
+	c.devices.Update(devices)
+
+	if !c.config.Publish {
+		return nil
+	}
+	for _, d := range devices {
+		out.Publish(c.build(client.DEVICE_INVENTORY, common.MapStr{
+			"event_type":       EventType,
+			"device_inventory": d.MapStr(),
+		}))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package inventory
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func TestCacheEnrich(t *testing.T) {
+	a := assert.New(t)
+
+	cache := NewCache()
+	cache.Update([]client.Device{{
+		UID:             "d1",
+		DeviceGroupName: "Servers",
+		OS:              client.DeviceOS{Name: "Windows"},
+		Owner:           "jdoe",
+	}})
+
+	fields := common.MapStr{"device_uid": "d1"}
+	cache.Enrich(fields)
+	group, _ := fields.GetValue("device_inventory.group.name")
+	a.Equal("Servers", group)
+	owner, _ := fields.GetValue("device_inventory.owner")
+	a.Equal("jdoe", owner)
+
+	unknown := common.MapStr{"device_uid": "d2"}
+	cache.Enrich(unknown)
+	a.Equal(common.MapStr{"device_uid": "d2"}, unknown)
+
+	var disabled *Cache
+	disabled.Enrich(unknown)
+}
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	server, sm := inputtest.NewSESServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sccs/v1/devices":
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprint(w, `{"total":3,"devices":[{"id":"d1"},{"id":"d2"}]}`)
+			} else {
+				fmt.Fprint(w, `{"total":3,"devices":[{"id":"d3","parent_device_group_name":"Servers"}]}`)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	config := DefaultConfig
+	config.BatchSize = 2
+	cache := NewCache()
+	c := NewCollector(config, sm, cache, inputtest.Build)
+
+	p := inputtest.NewOutlet(nil)
+	a.NoError(c.Collect(p, time.Now()))
+	if a.Len(p.Events, 3) {
+		a.Equal(EventType, p.Events[0].Fields["event_type"])
+		a.Equal("device_inventory", p.Events[0].Meta["pipeline"])
+	}
+
+	d, ok := cache.Get("d3")
+	a.True(ok)
+	a.Equal("Servers", d.DeviceGroupName)
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"devices.period", func(c *Config) { c.Period = 0 }},
+		{"devices.period", func(c *Config) { c.Period = -time.Minute }},
+		{"devices.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"devices.batch_size", func(c *Config) { c.BatchSize = client.MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
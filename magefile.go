@@ -56,15 +56,53 @@ func Package() {
 	defer func() { fmt.Println("package ran for", time.Since(start)) }()
 
 	devtools.UseCommunityBeatPackaging()
+	customizePackaging()
 
 	mg.Deps(update.Update)
 	mg.Deps(build.CrossBuild, build.CrossBuildGoDaemon)
 	mg.SerialDeps(devtools.Package, pkg.PackageTest)
 }
 
-// Config generates both the short/reference/docker configs.
+// Config generates both the short/reference/docker configs. The settings of
+// _meta/beat.yml are documented in full, so the reference config starts with
+// them too.
 func Config() error {
-	return devtools.Config(devtools.AllConfigTypes, devtools.ConfigFileParams{}, ".")
+	return devtools.Config(devtools.AllConfigTypes, devtools.ConfigFileParams{
+		ShortParts: []string{
+			devtools.OSSBeatDir("_meta/beat.yml"),
+			devtools.LibbeatDir("_meta/config.yml.tmpl"),
+		},
+		ReferenceParts: []string{
+			devtools.OSSBeatDir("_meta/beat.yml"),
+			devtools.LibbeatDir("_meta/config.reference.yml.tmpl"),
+		},
+		DockerParts: []string{
+			devtools.OSSBeatDir("_meta/beat.docker.yml"),
+			devtools.LibbeatDir("_meta/config.docker.yml"),
+		},
+	}, ".")
+}
+
+// customizePackaging adds the ingest pipeline definitions to the packages.
+func customizePackaging() {
+	ingest := devtools.PackageFile{
+		Mode:   0644,
+		Source: "pipeline/ingest",
+	}
+
+	for _, args := range devtools.Packages {
+		for _, pkgType := range args.Types {
+			switch pkgType {
+			case devtools.Deb, devtools.RPM:
+				args.Spec.Files["/usr/share/{{.BeatName}}/ingest"] = ingest
+			case devtools.DMG:
+				args.Spec.Files["/Library/Application Support/{{.BeatVendor}}/{{.BeatName}}/ingest"] = ingest
+			default:
+				args.Spec.Files["ingest"] = ingest
+			}
+			break
+		}
+	}
 }
 
 //Fields generates a fields.yml for the Beat.
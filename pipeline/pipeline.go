@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+const (
+	// Dir is the directory, relative to the beat home, holding the ingest
+	// pipeline definitions.
+	Dir = "ingest"
+
+	defaultPipeline = "default"
+	networkPipeline = "network"
+	webPipeline     = "web"
+)
+
+// Loader is the subset of the Elasticsearch client API needed to load the
+// ingest pipelines.
+type Loader interface {
+	LoadJSON(path string, json map[string]interface{}) ([]byte, error)
+	Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error)
+	GetVersion() common.Version
+}
+
+// ID returns the ingest pipeline ID of the named pipeline for the given beat
+// version.
+func ID(version, name string) string {
+	return fmt.Sprintf("symantecbeat-%s-%s", version, name)
+}
+
+// ForEventType returns the name of the pipeline events of the given type
+// are processed with.
+func ForEventType(t client.EventType) string {
+	switch t {
+	case client.WEB_SECURITY:
+		return webPipeline
+	case client.FIREWALL, client.NETWORK_IPS, client.NETWORK_INTEGRITY, client.DECEPTION:
+		return networkPipeline
+	default:
+		return defaultPipeline
+	}
+}
+
+// Found tells whether the ingest directory of the beat home has pipeline
+// definitions, which it has not when the beat runs from a build without it.
+func Found() bool {
+	files, err := filepath.Glob(filepath.Join(paths.Resolve(paths.Home, Dir), "*.json"))
+	return err == nil && len(files) > 0
+}
+
+// Load reads every pipeline definition from the ingest directory of the beat
+// home and loads it into Elasticsearch. Existing pipelines are only replaced
+// when overwrite is set.
+func Load(esClient Loader, version string, overwrite bool) error {
+	return LoadDir(esClient, paths.Resolve(paths.Home, Dir), version, overwrite)
+}
+
+// LoadDir loads every pipeline definition found in dir into Elasticsearch.
+func LoadDir(esClient Loader, dir, version string, overwrite bool) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no ingest pipelines found in %s", dir)
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		content, err := readPipeline(file, version)
+		if err != nil {
+			return fmt.Errorf("error reading pipeline %s: %v", name, err)
+		}
+		if err := loadPipeline(esClient, ID(version, name), content, overwrite); err != nil {
+			return fmt.Errorf("error loading pipeline %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// readPipeline decodes a pipeline definition and resolves the names of the
+// pipelines it calls into pipeline IDs.
+func readPipeline(file, version string) (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, err
+	}
+
+	processors, _ := content["processors"].([]interface{})
+	for _, p := range processors {
+		processor, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if options, ok := processor["pipeline"].(map[string]interface{}); ok {
+			if name, ok := options["name"].(string); ok {
+				options["name"] = ID(version, name)
+			}
+		}
+	}
+	return content, nil
+}
+
+func loadPipeline(esClient Loader, id string, content map[string]interface{}, overwrite bool) error {
+	path := "/_ingest/pipeline/" + id
+	if !overwrite {
+		status, _, _ := esClient.Request("GET", path, "", nil, nil)
+		if status == 200 {
+			logp.Debug("pipeline", "Pipeline %s already loaded", id)
+			return nil
+		}
+	}
+
+	body, err := esClient.LoadJSON(path, content)
+	if err != nil {
+		return fmt.Errorf("%v: %s", err, body)
+	}
+	logp.Info("Elasticsearch pipeline with ID '%s' loaded", id)
+	return nil
+}
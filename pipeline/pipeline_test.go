@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+type fakeLoader struct {
+	loaded map[string]map[string]interface{}
+}
+
+func (l *fakeLoader) LoadJSON(path string, json map[string]interface{}) ([]byte, error) {
+	l.loaded[path] = json
+	return nil, nil
+}
+
+func (l *fakeLoader) Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error) {
+	return 404, nil, nil
+}
+
+func (l *fakeLoader) GetVersion() common.Version {
+	return *common.MustNewVersion("7.4.0")
+}
+
+func TestForEventType(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("web", ForEventType(client.WEB_SECURITY))
+	a.Equal("network", ForEventType(client.FIREWALL))
+	a.Equal("default", ForEventType(client.MALWARE_PROTECTION))
+}
+
+func TestLoadDir(t *testing.T) {
+	a := assert.New(t)
+
+	loader := &fakeLoader{loaded: map[string]map[string]interface{}{}}
+	a.NoError(LoadDir(loader, Dir, "7.4.0", false))
+
+	a.Len(loader.loaded, 3)
+	web, ok := loader.loaded["/_ingest/pipeline/symantecbeat-7.4.0-web"]
+	a.True(ok)
+
+	processors := web["processors"].([]interface{})
+	call := processors[len(processors)-1].(map[string]interface{})["pipeline"].(map[string]interface{})
+	a.Equal("symantecbeat-7.4.0-network", call["name"])
+}
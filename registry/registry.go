@@ -0,0 +1,199 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+// Store is a small persistent key/value store, kept in a JSON file under the
+// beat data directory. Collectors use it to persist their checkpoints and
+// state across restarts.
+type Store struct {
+	mutex sync.Mutex
+	// saveMutex serialises the writes of the file.
+	saveMutex sync.Mutex
+	path      string
+	data      map[string]json.RawMessage
+	// held keeps Save from writing the changes until Release.
+	held bool
+}
+
+var (
+	storesMutex sync.Mutex
+	stores      = map[string]*Store{}
+
+	idPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// CheckID checks that id can name a store, or a directory of stores: it is
+// made of letters, digits, '_', '-' and '.' and is not a relative path
+// element.
+func CheckID(id string) error {
+	if !idPattern.MatchString(id) || id == "." || id == ".." {
+		return fmt.Errorf("invalid id '%s', only letters, digits, '_', '-' and '.' are allowed", id)
+	}
+	return nil
+}
+
+// Open opens the named store of the beat data directory, creating it when it
+// does not exist yet. The store is shared by everything opening the name, so
+// a collector restarted on a config reload finds the checkpoints its previous
+// instance kept. name is made of ids separated by '/', e.g. the tenant and
+// the input.
+func Open(name string) (*Store, error) {
+	for _, id := range strings.Split(name, "/") {
+		if err := CheckID(id); err != nil {
+			return nil, fmt.Errorf("error opening registry %s: %v", name, err)
+		}
+	}
+	path := paths.Resolve(paths.Data, filepath.Join("registry", name+".json"))
+
+	storesMutex.Lock()
+	defer storesMutex.Unlock()
+
+	if s, ok := stores[path]; ok {
+		return s, nil
+	}
+	s, err := OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	stores[path] = s
+	return s, nil
+}
+
+// OpenFile opens the store kept in the given file.
+func OpenFile(path string) (*Store, error) {
+	s := &Store{
+		path: path,
+		data: map[string]json.RawMessage{},
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &s.data); err != nil {
+		return nil, fmt.Errorf("error decoding registry %s: %v", path, err)
+	}
+	return s, nil
+}
+
+// Get decodes the value stored under key into v. It returns false when there
+// is no such key.
+func (s *Store) Get(key string, v interface{}) (bool, error) {
+	s.mutex.Lock()
+	raw, ok := s.data[key]
+	s.mutex.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+// Set stores v under key. Call Save to persist it.
+func (s *Store) Set(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.data[key] = raw
+	return nil
+}
+
+// Delete removes key from the store. Call Save to persist it.
+func (s *Store) Delete(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.data, key)
+}
+
+// Keys returns the sorted keys of the store.
+func (s *Store) Keys() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keys := make([]string, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Hold keeps the changes in memory, Save does not write them until Release.
+// The run once mode holds the checkpoints until their events are
+// acknowledged.
+func (s *Store) Hold() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.held = true
+}
+
+// Release writes the changes held since Hold.
+func (s *Store) Release() error {
+	s.mutex.Lock()
+	s.held = false
+	s.mutex.Unlock()
+	return s.Save()
+}
+
+// Save writes the store to disk. The file is replaced atomically so a crash
+// never leaves a truncated registry behind.
+func (s *Store) Save() error {
+	s.saveMutex.Lock()
+	defer s.saveMutex.Unlock()
+
+	s.mutex.Lock()
+	if s.held {
+		s.mutex.Unlock()
+		return nil
+	}
+	content, err := json.Marshal(s.data)
+	s.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0750); err != nil {
+		return err
+	}
+	tmp := s.path + ".new"
+	if err := ioutil.WriteFile(tmp, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,150 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package registry
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+func TestStore(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "registry")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "registry", "test.json")
+
+	s, err := OpenFile(path)
+	a.NoError(err)
+
+	var checkpoint time.Time
+	found, err := s.Get("checkpoint", &checkpoint)
+	a.NoError(err)
+	a.False(found)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	a.NoError(s.Set("checkpoint", now))
+	a.NoError(s.Set("other", 1))
+	s.Delete("other")
+	a.NoError(s.Save())
+
+	s, err = OpenFile(path)
+	a.NoError(err)
+	found, err = s.Get("checkpoint", &checkpoint)
+	a.NoError(err)
+	a.True(found)
+	a.True(now.Equal(checkpoint))
+	a.Equal([]string{"checkpoint"}, s.Keys())
+}
+
+func TestOpenShares(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "registry")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	a.NoError(paths.InitPaths(&paths.Path{Home: dir}))
+
+	s, err := Open("shared")
+	a.NoError(err)
+	a.NoError(s.Set("checkpoint", 1))
+
+	other, err := Open("shared")
+	a.NoError(err)
+	a.True(s == other)
+
+	var checkpoint int
+	found, err := other.Get("checkpoint", &checkpoint)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(1, checkpoint)
+}
+
+func TestOpenChecksName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, paths.InitPaths(&paths.Path{Home: dir}))
+
+	for _, name := range []string{"../x", "acme/..", "acme//edr", "", "a b"} {
+		_, err := Open(name)
+		assert.Error(t, err, name)
+	}
+	_, err = Open("acme-1/edr_2.x")
+	assert.NoError(t, err)
+}
+
+func TestSaveConcurrently(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "registry")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "concurrent.json")
+
+	s, err := OpenFile(path)
+	a.NoError(err)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			a.NoError(s.Set(strconv.Itoa(i), i))
+			a.NoError(s.Save())
+		}(i)
+	}
+	wg.Wait()
+
+	s, err = OpenFile(path)
+	a.NoError(err)
+	a.Len(s.Keys(), 20)
+}
+
+func TestHold(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "registry")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "held.json")
+
+	s, err := OpenFile(path)
+	a.NoError(err)
+	s.Hold()
+	a.NoError(s.Set("checkpoint", 1))
+	a.NoError(s.Save())
+	_, err = os.Stat(path)
+	a.True(os.IsNotExist(err))
+
+	a.NoError(s.Release())
+	s, err = OpenFile(path)
+	a.NoError(err)
+	a.Equal([]string{"checkpoint"}, s.Keys())
+}
@@ -0,0 +1,370 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sepm
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+const (
+	authenticateURL  = "/sepm/api/v1/identity/authenticate"
+	computersURL     = "/sepm/api/v1/computers"
+	criticalEventURL = "/sepm/api/v1/events/critical"
+	commandQueueURL  = "/sepm/api/v1/command-queue"
+
+	// tokenRefreshMargin is how long before its expiration a token is
+	// renewed.
+	tokenRefreshMargin = time.Minute
+)
+
+var errUnauthorized = errors.New("SEPM request unauthorized")
+
+// Client talks to the REST API of an on-premises Symantec Endpoint Protection
+// Manager.
+type Client struct {
+	URL      string
+	Username string
+	Password client.Secret
+	Domain   string
+
+	httpClient *http.Client
+	token      client.Secret
+	expires    time.Time
+	logger     *logp.Logger
+}
+
+// NewClient creates a SEPM client. tlsConfig may be nil to use the system
+// defaults.
+func NewClient(url, username string, password client.Secret, domain string, tlsConfig *tls.Config) *Client {
+	return &Client{
+		URL:        url,
+		Username:   username,
+		Password:   password,
+		Domain:     domain,
+		httpClient: client.NewHTTPClient(tlsConfig),
+		logger:     logp.NewLogger("sepm_client"),
+	}
+}
+
+// String describes the client without its credentials, which keeps its
+// token out of the logs.
+func (c *Client) String() string {
+	return fmt.Sprintf("SEPM client url=%s username=%s domain=%s", c.URL, c.Username, c.Domain)
+}
+
+// GoString describes the client without its credentials in %#v.
+func (c *Client) GoString() string {
+	return c.String()
+}
+
+type authenticateRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Domain   string `json:"domain"`
+}
+
+type authenticateResponse struct {
+	Token string `json:"token"`
+	// TokenExpiration is the validity of the token in seconds.
+	TokenExpiration int `json:"tokenExpiration"`
+}
+
+// Authenticate logs in with the username and password and stores the access
+// token used by the following requests.
+func (c *Client) Authenticate() error {
+	body, err := json.Marshal(authenticateRequest{
+		Username: c.Username,
+		Password: string(c.Password),
+		Domain:   c.Domain,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.URL+authenticateURL, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	response, err := c.send(req)
+	if err != nil {
+		return fmt.Errorf("error authenticating to SEPM: %v", err)
+	}
+
+	var auth authenticateResponse
+	if err := json.Unmarshal(response, &auth); err != nil {
+		return fmt.Errorf("error decoding SEPM authentication response: %v", err)
+	}
+	if auth.Token == "" {
+		return fmt.Errorf("SEPM authentication returned no token")
+	}
+	c.token = client.Secret(auth.Token)
+	c.expires = time.Now().Add(time.Duration(auth.TokenExpiration) * time.Second)
+	c.logger.Infof("Authenticated to SEPM as %s, token valid for %ds", c.Username, auth.TokenExpiration)
+	return nil
+}
+
+// get sends an authenticated GET request and decodes the response into v. The
+// token is renewed before it expires, and once more when SEPM rejects it.
+func (c *Client) get(path string, params url.Values, v interface{}) error {
+	if c.token == "" || time.Now().Add(tokenRefreshMargin).After(c.expires) {
+		if err := c.Authenticate(); err != nil {
+			return err
+		}
+	}
+
+	uri := c.URL + path
+	if len(params) > 0 {
+		uri += "?" + params.Encode()
+	}
+
+	var response []byte
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, uri, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Add("Authorization", "Bearer "+string(c.token))
+		req.Header.Add("Accept", "application/json")
+
+		response, err = c.send(req)
+		if err == errUnauthorized && attempt == 0 {
+			c.logger.Info("SEPM token rejected, authenticating again")
+			if err := c.Authenticate(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		break
+	}
+
+	if err := json.Unmarshal(response, v); err != nil {
+		return fmt.Errorf("error decoding %s response: %v", path, err)
+	}
+	return nil
+}
+
+// send sends the request and returns the body of the response. Responses
+// other than 200 OK are returned as errors.
+func (c *Client) send(req *http.Request) ([]byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	c.logger.Debugf("Server response=%d", resp.StatusCode)
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusUnauthorized:
+		return nil, errUnauthorized
+	default:
+		return nil, fmt.Errorf("%s %s returned %s", req.Method, req.URL.Path, resp.Status)
+	}
+}
+
+// Millis is a time sent by SEPM as milliseconds since the epoch, either as a
+// JSON number or as a string.
+type Millis time.Time
+
+// UnmarshalJSON decodes the epoch milliseconds.
+func (m *Millis) UnmarshalJSON(b []byte) error {
+	s := string(bytes.Trim(b, `"`))
+	if s == "" || s == "null" {
+		*m = Millis{}
+		return nil
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid epoch milliseconds %s: %v", s, err)
+	}
+	*m = Millis(time.Unix(0, ms*int64(time.Millisecond)).UTC())
+	return nil
+}
+
+// Time returns m as a time.Time.
+func (m Millis) Time() time.Time {
+	return time.Time(m)
+}
+
+func millis(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// Computer is a client computer managed by SEPM.
+type Computer struct {
+	UniqueID       string        `json:"uniqueId"`
+	Name           string        `json:"computerName"`
+	Domain         string        `json:"domainOrWorkgroup"`
+	IPAddresses    []string      `json:"ipAddresses"`
+	MACAddresses   []string      `json:"macAddresses"`
+	OSName         string        `json:"operatingSystem"`
+	OSVersion      string        `json:"osVersion"`
+	AgentVersion   string        `json:"agentVersion"`
+	Group          ComputerGroup `json:"group"`
+	LogonUserName  string        `json:"logonUserName"`
+	OnlineStatus   int           `json:"onlineStatus"`
+	Infected       int           `json:"infected"`
+	LastUpdateTime Millis        `json:"lastUpdateTime"`
+}
+
+// ComputerGroup is the SEPM group of a computer.
+type ComputerGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type computersResponse struct {
+	Content    []Computer `json:"content"`
+	LastPage   bool       `json:"lastPage"`
+	TotalPages int        `json:"totalPages"`
+}
+
+// GetComputers pages through the computers updated since the given time,
+// handing every page to handle. It stops at the first error returned by
+// handle.
+func (c *Client) GetComputers(since time.Time, pageSize int, handle func([]Computer) error) error {
+	total := 0
+	for page := 1; ; page++ {
+		params := url.Values{}
+		params.Set("pageIndex", strconv.Itoa(page))
+		params.Set("pageSize", strconv.Itoa(pageSize))
+		params.Set("lastUpdate", millis(since))
+
+		var response computersResponse
+		if err := c.get(computersURL, params, &response); err != nil {
+			return err
+		}
+		if err := handle(response.Content); err != nil {
+			return err
+		}
+		total += len(response.Content)
+
+		if response.LastPage || page >= response.TotalPages || len(response.Content) == 0 {
+			break
+		}
+	}
+	c.logger.Infof("Got %d updated computers", total)
+	return nil
+}
+
+// CriticalEvent is a critical event of the SEPM notifications.
+type CriticalEvent struct {
+	ID           string `json:"eventId"`
+	DateTime     string `json:"eventDateTime"`
+	Subject      string `json:"subject"`
+	Message      string `json:"message"`
+	Acknowledged int    `json:"acknowledged"`
+}
+
+// criticalEventTimeFormat is the format of eventDateTime, in UTC.
+const criticalEventTimeFormat = "2006-01-02 15:04:05"
+
+// Time parses the time the event occurred.
+func (e CriticalEvent) Time() (time.Time, error) {
+	return time.Parse(criticalEventTimeFormat, e.DateTime)
+}
+
+type criticalEventsResponse struct {
+	Events []CriticalEvent `json:"criticalEventsInfoList"`
+}
+
+// GetCriticalEvents returns the critical events kept by SEPM. The endpoint is
+// not paginated.
+func (c *Client) GetCriticalEvents() ([]CriticalEvent, error) {
+	var response criticalEventsResponse
+	if err := c.get(criticalEventURL, nil, &response); err != nil {
+		return nil, err
+	}
+	c.logger.Infof("Got %d critical events", len(response.Events))
+	return response.Events, nil
+}
+
+// Command is the status of a command sent to a computer.
+type Command struct {
+	ID             string `json:"commandId"`
+	Name           string `json:"commandName"`
+	ComputerID     string `json:"computerId"`
+	ComputerName   string `json:"computerName"`
+	ComputerIP     string `json:"computerIp"`
+	DomainName     string `json:"domainName"`
+	UserName       string `json:"currentLoginUserName"`
+	StateID        int    `json:"stateId"`
+	SubStateID     int    `json:"subStateId"`
+	SubStateDesc   string `json:"subStateDesc"`
+	BeginTime      Millis `json:"beginTime"`
+	LastUpdateTime Millis `json:"lastUpdateTime"`
+}
+
+type commandsResponse struct {
+	Content    []Command `json:"content"`
+	LastPage   bool      `json:"lastPage"`
+	TotalPages int       `json:"totalPages"`
+}
+
+// GetCommands pages through the status of the commands issued between start
+// and end, handing every page to handle. It stops at the first error returned
+// by handle.
+func (c *Client) GetCommands(start, end time.Time, pageSize int, handle func([]Command) error) error {
+	total := 0
+	for page := 1; ; page++ {
+		params := url.Values{}
+		params.Set("pageIndex", strconv.Itoa(page))
+		params.Set("pageSize", strconv.Itoa(pageSize))
+		params.Set("startDate", millis(start))
+		params.Set("endDate", millis(end))
+
+		var response commandsResponse
+		if err := c.get(commandQueueURL, params, &response); err != nil {
+			return err
+		}
+		if err := handle(response.Content); err != nil {
+			return err
+		}
+		total += len(response.Content)
+
+		if response.LastPage || page >= response.TotalPages || len(response.Content) == 0 {
+			break
+		}
+	}
+	c.logger.Infof("Got %d command statuses", total)
+	return nil
+}
@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sepm
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	// ComputerEventType is the event_type of the SEPM computer documents.
+	ComputerEventType = "SEPM COMPUTER"
+	// CriticalEventType is the event_type of the SEPM critical events.
+	CriticalEventType = "SEPM CRITICAL EVENT"
+	// CommandEventType is the event_type of the SEPM command statuses.
+	CommandEventType = "SEPM COMMAND"
+
+	productName = "Symantec Endpoint Protection"
+
+	// criticalSeverity is the SES severity_id of critical events.
+	criticalSeverity = 5
+)
+
+// newFields returns the SES envelope shared by every SEPM document.
+func newFields(eventType string, ts time.Time) common.MapStr {
+	return common.MapStr{
+		"event_type":   eventType,
+		"time":         ts.Format(time.RFC3339Nano),
+		"product_name": productName,
+	}
+}
+
+// putDevice copies the device of a SEPM record into the SES device fields,
+// leaving out the unknown ones.
+func putDevice(fields common.MapStr, uid, name, domain, ip, user string) {
+	for key, value := range map[string]string{
+		"device_uid":    uid,
+		"device_name":   name,
+		"device_domain": domain,
+		"device_ip":     ip,
+		"user_name":     user,
+	} {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+}
+
+func computerEvent(c Computer) beat.Event {
+	ts := c.LastUpdateTime.Time()
+	fields := newFields(ComputerEventType, ts)
+	var ip string
+	if len(c.IPAddresses) > 0 {
+		ip = c.IPAddresses[0]
+	}
+	putDevice(fields, c.UniqueID, c.Name, c.Domain, ip, c.LogonUserName)
+	fields.Update(common.MapStr{
+		"device_group":   c.Group.Name,
+		"device_os_name": c.OSName,
+		"device_os_ver":  c.OSVersion,
+		"product_ver":    c.AgentVersion,
+		"sepm": common.MapStr{
+			"computer": common.MapStr{
+				"group_id": c.Group.ID,
+				"ip":       c.IPAddresses,
+				"mac":      c.MACAddresses,
+				"online":   c.OnlineStatus == 1,
+				"infected": c.Infected == 1,
+			},
+		},
+	})
+	return beat.Event{Timestamp: ts, Fields: fields}
+}
+
+func criticalEvent(e CriticalEvent, ts time.Time) beat.Event {
+	fields := newFields(CriticalEventType, ts)
+	fields.Update(common.MapStr{
+		"uuid":        e.ID,
+		"severity_id": criticalSeverity,
+		"message":     e.Message,
+		"sepm": common.MapStr{
+			"critical_event": common.MapStr{
+				"subject":      e.Subject,
+				"acknowledged": e.Acknowledged == 1,
+			},
+		},
+	})
+	return beat.Event{Timestamp: ts, Fields: fields}
+}
+
+func commandEvent(c Command) beat.Event {
+	ts := c.LastUpdateTime.Time()
+	fields := newFields(CommandEventType, ts)
+	putDevice(fields, c.ComputerID, c.ComputerName, c.DomainName, c.ComputerIP, c.UserName)
+	fields.Update(common.MapStr{
+		"status_id":     c.StateID,
+		"status_detail": c.SubStateDesc,
+		"sepm": common.MapStr{
+			"command": common.MapStr{
+				"id":           c.ID,
+				"name":         c.Name,
+				"sub_state_id": c.SubStateID,
+				"begin_time":   c.BeginTime.Time().Format(time.RFC3339Nano),
+			},
+		},
+	})
+	return beat.Event{Timestamp: ts, Fields: fields}
+}
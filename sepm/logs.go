@@ -0,0 +1,274 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sepm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+// Categories of the logs SEPM sends to a syslog server.
+const (
+	SecurityLog = "security"
+	TrafficLog  = "traffic"
+	PacketLog   = "packet"
+	RiskLog     = "risk"
+	ScanLog     = "scan"
+	UnknownLog  = "unknown"
+)
+
+// logTypes maps the log categories to the SES event type whose ingest
+// pipeline and index they share, and to their event_type.
+var logTypes = map[string]struct {
+	eventType client.EventType
+	name      string
+}{
+	SecurityLog: {client.NETWORK_IPS, "SEPM SECURITY"},
+	TrafficLog:  {client.FIREWALL, "SEPM TRAFFIC"},
+	PacketLog:   {client.FIREWALL, "SEPM PACKET"},
+	RiskLog:     {client.MALWARE_PROTECTION, "SEPM RISK"},
+	ScanLog:     {client.MALWARE_PROTECTION, "SEPM SCAN"},
+	UnknownLog:  {client.UNKNOWN, "SEPM LOG"},
+}
+
+// syslogHeader matches the RFC 3164 header SEPM puts in front of its records,
+// e.g. "<54>Apr  8 12:00:00 sepm01 SymantecServer: ".
+var syslogHeader = regexp.MustCompile(`^(?:<\d{1,3}>)?(?:[A-Z][a-z]{2} [ \d]\d \d\d:\d\d:\d\d )?(?:(\S+) )?SymantecServer(?:\[\d+\])?: ?`)
+
+// logKey matches the "Key: " prefix of a key/value field.
+var logKey = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9 ()/_\-.]*?): ?`)
+
+// record is a SEPM log record split into its key/value fields and the values
+// that come without a key, in order.
+type record struct {
+	fields map[string]string
+	values []string
+}
+
+func parseRecord(payload string) record {
+	r := record{fields: map[string]string{}}
+	for _, part := range strings.Split(payload, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if m := logKey.FindStringSubmatch(part); m != nil {
+			r.fields[m[1]] = strings.TrimSpace(part[len(m[0]):])
+			continue
+		}
+		r.values = append(r.values, part)
+	}
+	return r
+}
+
+// get returns the first non empty value of the given keys.
+func (r record) get(keys ...string) string {
+	for _, k := range keys {
+		if v := r.fields[k]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func (r record) has(key string) bool {
+	_, ok := r.fields[key]
+	return ok
+}
+
+// hasValue reports whether one of the values without a key is v.
+func (r record) hasValue(v string) bool {
+	for _, value := range r.values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r record) category() string {
+	switch {
+	case r.has("Risk name"):
+		return RiskLog
+	case r.has("Scan ID"):
+		return ScanLog
+	case r.has("CIDS Signature ID") || r.has("Intrusion ID") || r.has("Event Description"):
+		return SecurityLog
+	case r.has("Local Host IP") && r.has("Rule"):
+		return TrafficLog
+	case r.has("Local Host IP"):
+		return PacketLog
+	default:
+		return UnknownLog
+	}
+}
+
+// ParseLog parses a syslog message sent by SEPM into fields in the SES
+// layout. It returns the SES event type the record is processed as. Records
+// whose layout is not recognised are kept in message with the unknown
+// category.
+func ParseLog(line string) (client.EventType, common.MapStr) {
+	line = strings.TrimSpace(line)
+	var server string
+	payload := line
+	if m := syslogHeader.FindStringSubmatch(line); m != nil {
+		server = m[1]
+		payload = line[len(m[0]):]
+	}
+
+	r := parseRecord(payload)
+	category := r.category()
+	logType := logTypes[category]
+
+	ts, ok := r.time("Begin", "Event time", "Event Insert Time")
+	if !ok {
+		ts = time.Now().UTC()
+	}
+	fields := newFields(logType.name, ts)
+	fields.Put("sepm.log.category", category)
+	if s := r.get("Server Name"); s != "" {
+		server = s
+	}
+	if server != "" {
+		fields.Put("sepm.log.server", server)
+	}
+	putDevice(fields, "", r.get("Computer name", "Computer"), r.get("Domain Name"),
+		r.get("IP Address", "Local Host IP"), r.get("User Name", "User1"))
+	putString(fields, "device_group", r.get("Group Name"))
+	putString(fields, "location.name", r.get("Location"))
+
+	switch category {
+	case SecurityLog, TrafficLog, PacketLog:
+		r.putConnection(fields)
+		putString(fields, "sepm.log.action", r.get("Action"))
+		putString(fields, "policy.rule_name", r.get("Rule"))
+		putString(fields, "message", r.get("Event Description"))
+		putString(fields, "signature.name", r.get("CIDS Signature string"))
+		putInt(fields, "signature.id", r.get("CIDS Signature ID", "Intrusion ID"))
+		if len(r.values) > 0 && fields["device_name"] == nil {
+			fields["device_name"] = r.values[0]
+		}
+	case RiskLog:
+		putString(fields, "threat.name", r.get("Risk name"))
+		putString(fields, "file.path", r.get("File path"))
+		if r.get("Hash type") == "SHA2" {
+			putString(fields, "file.sha2", r.get("Application hash"))
+		}
+		putString(fields, "scan_name", r.get("Source"))
+		putString(fields, "sepm.log.action", r.get("Actual action"))
+		if len(r.values) > 0 {
+			fields["message"] = r.values[0]
+		}
+	case ScanLog:
+		putString(fields, "scan_uid", r.get("Scan ID"))
+		if len(r.values) > 0 {
+			fields.Put("sepm.log.status", r.values[0])
+		}
+		if len(r.values) > 1 {
+			fields["message"] = r.values[1]
+		}
+	default:
+		fields["message"] = payload
+	}
+
+	return logType.eventType, fields
+}
+
+// time parses the first of the given time fields, sent in UTC.
+func (r record) time(keys ...string) (time.Time, bool) {
+	for _, k := range keys {
+		if v := r.fields[k]; v != "" {
+			if t, err := time.Parse(criticalEventTimeFormat, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// protocols maps the protocol names used by SEPM to their IANA numbers.
+var protocols = map[string]int{
+	"ICMP":   1,
+	"TCP":    6,
+	"UDP":    17,
+	"ICMPV6": 58,
+}
+
+// putConnection sets the connection fields. SEPM reports the local and remote
+// ends, which are the destination and the source of inbound traffic.
+func (r record) putConnection(fields common.MapStr) {
+	local := common.MapStr{}
+	remote := common.MapStr{}
+	putString(local, "ip", r.get("Local Host IP"))
+	putInt(local, "port", r.get("Local Port"))
+	putString(local, "mac", r.get("Local Host MAC"))
+	putString(remote, "ip", r.get("Remote Host IP"))
+	putInt(remote, "port", r.get("Remote Port"))
+	putString(remote, "mac", r.get("Remote Host MAC"))
+	putString(remote, "name", r.get("Remote Host Name"))
+
+	direction := r.get("Direction")
+	if direction == "" {
+		for _, d := range []string{"Inbound", "Outbound"} {
+			if r.hasValue(d) {
+				direction = d
+			}
+		}
+	}
+	src, dst := local, remote
+	connection := common.MapStr{}
+	switch strings.ToLower(direction) {
+	case "inbound":
+		src, dst = remote, local
+		connection["direction_id"] = 1
+	case "outbound":
+		connection["direction_id"] = 2
+	}
+	for k, v := range src {
+		connection["src_"+k] = v
+	}
+	for k, v := range dst {
+		connection["dst_"+k] = v
+	}
+	for name, id := range protocols {
+		if r.hasValue(name) {
+			connection["protocol_id"] = id
+		}
+	}
+	if len(connection) > 0 {
+		fields["connection"] = connection
+	}
+}
+
+func putString(fields common.MapStr, key, value string) {
+	if value != "" {
+		fields.Put(key, value)
+	}
+}
+
+func putInt(fields common.MapStr, key, value string) {
+	if i, err := strconv.Atoi(value); err == nil {
+		fields.Put(key, i)
+	}
+}
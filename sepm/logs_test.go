@@ -0,0 +1,190 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package sepm
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+)
+
+func TestParseLog(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		eventType client.EventType
+		expected  common.MapStr
+	}{
+		{
+			name: "security",
+			line: "<54>Apr  8 12:00:00 sepm01 SymantecServer: host1,Event Description: [SID: 23179] OS Attack: MS SMB blocked," +
+				"Local Host IP: 10.0.0.5,Local Host MAC: 000C29000001,Remote Host Name: ,Remote Host IP: 198.51.100.7," +
+				"Remote Host MAC: 000C29000002,Inbound,TCP,Intrusion ID: 0,Begin: 2020-04-08 11:59:00,End Time: 2020-04-08 11:59:00," +
+				"Occurrences: 1,Application: ,Location: Default,User Name: bob,Domain Name: CORP,Local Port: 445,Remote Port: 51234," +
+				"CIDS Signature ID: 23179,CIDS Signature string: OS Attack: MS SMB,CIDS Signature SubID: 70000",
+			eventType: client.NETWORK_IPS,
+			expected: common.MapStr{
+				"event_type":    "SEPM SECURITY",
+				"time":          "2020-04-08T11:59:00Z",
+				"device_name":   "host1",
+				"device_ip":     "10.0.0.5",
+				"device_domain": "CORP",
+				"user_name":     "bob",
+				"message":       "[SID: 23179] OS Attack: MS SMB blocked",
+				"signature":     common.MapStr{"id": 23179, "name": "OS Attack: MS SMB"},
+				"connection": common.MapStr{
+					"src_ip":       "198.51.100.7",
+					"src_port":     51234,
+					"src_mac":      "000C29000002",
+					"dst_ip":       "10.0.0.5",
+					"dst_port":     445,
+					"dst_mac":      "000C29000001",
+					"direction_id": 1,
+					"protocol_id":  6,
+				},
+				"sepm": common.MapStr{"log": common.MapStr{"category": "security", "server": "sepm01"}},
+			},
+		},
+		{
+			name: "traffic",
+			line: "<54>Apr  8 12:00:00 sepm01 SymantecServer: host1,Local Host IP: 10.0.0.5,Local Port: 138,Remote Host IP: 10.0.0.255," +
+				"Remote Port: 138,UDP,Outbound,Begin: 2020-04-08 11:59:00,Rule: Block all other traffic,Action: Blocked",
+			eventType: client.FIREWALL,
+			expected: common.MapStr{
+				"event_type":  "SEPM TRAFFIC",
+				"device_name": "host1",
+				"device_ip":   "10.0.0.5",
+				"policy":      common.MapStr{"rule_name": "Block all other traffic"},
+				"connection": common.MapStr{
+					"src_ip":       "10.0.0.5",
+					"src_port":     138,
+					"dst_ip":       "10.0.0.255",
+					"dst_port":     138,
+					"direction_id": 2,
+					"protocol_id":  17,
+				},
+				"sepm": common.MapStr{"log": common.MapStr{"category": "traffic", "server": "sepm01", "action": "Blocked"}},
+			},
+		},
+		{
+			name: "risk",
+			line: "SymantecServer: Virus found,IP Address: 10.0.0.5,Computer name: host1,Source: Auto-Protect scan," +
+				"Risk name: EICAR Test String,Occurrences: 1,File path: C:\\eicar.com,Actual action: Cleaned by deletion," +
+				"Event time: 2020-04-08 11:59:00,Group Name: My Company\\Default,Server Name: sepm01,User Name: bob," +
+				"Application hash: 275A021BBFB6489E54D471899F7DB9D1663FC695EC2FE2A2C4538AABF651FD0F,Hash type: SHA2",
+			eventType: client.MALWARE_PROTECTION,
+			expected: common.MapStr{
+				"event_type":   "SEPM RISK",
+				"device_name":  "host1",
+				"device_ip":    "10.0.0.5",
+				"device_group": "My Company\\Default",
+				"message":      "Virus found",
+				"threat":       common.MapStr{"name": "EICAR Test String"},
+				"scan_name":    "Auto-Protect scan",
+				"file": common.MapStr{
+					"path": "C:\\eicar.com",
+					"sha2": "275A021BBFB6489E54D471899F7DB9D1663FC695EC2FE2A2C4538AABF651FD0F",
+				},
+				"sepm": common.MapStr{"log": common.MapStr{"category": "risk", "server": "sepm01", "action": "Cleaned by deletion"}},
+			},
+		},
+		{
+			name: "scan",
+			line: "<54>Apr  8 12:00:00 sepm01 SymantecServer: Scan ID: 1586346000,Begin: 2020-04-08 11:59:00,End Time: 2020-04-08 12:00:00," +
+				"Completed,Duration (seconds): 60,User1: bob,User2: SYSTEM,Scan started on all drives.,Computer: host1,IP Address: 10.0.0.5",
+			eventType: client.MALWARE_PROTECTION,
+			expected: common.MapStr{
+				"event_type":  "SEPM SCAN",
+				"device_name": "host1",
+				"user_name":   "bob",
+				"scan_uid":    "1586346000",
+				"message":     "Scan started on all drives.",
+				"sepm":        common.MapStr{"log": common.MapStr{"category": "scan", "server": "sepm01", "status": "Completed"}},
+			},
+		},
+		{
+			name:      "unknown",
+			line:      "<54>Apr  8 12:00:00 sepm01 SymantecServer: Site: Site sepm01,Server: sepm01,Domain: Default,Admin: admin,Logged in",
+			eventType: client.UNKNOWN,
+			expected: common.MapStr{
+				"event_type": "SEPM LOG",
+				"message":    "Site: Site sepm01,Server: sepm01,Domain: Default,Admin: admin,Logged in",
+				"sepm":       common.MapStr{"log": common.MapStr{"category": "unknown", "server": "sepm01"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			eventType, fields := ParseLog(test.line)
+			assert.Equal(t, test.eventType, eventType)
+			assert.Equal(t, productName, fields["product_name"])
+			for key, expected := range test.expected.Flatten() {
+				value, err := fields.GetValue(key)
+				assert.NoError(t, err, key)
+				assert.Equal(t, expected, value, key)
+			}
+		})
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	config := DefaultSyslogConfig
+	config.Enabled = true
+	config.Host = "127.0.0.1:0"
+
+	events := make(chan beat.Event, 1)
+	build := func(t client.EventType, fields common.MapStr) beat.Event {
+		return beat.Event{Fields: fields}
+	}
+	r := NewReceiver(config, build)
+	s, err := r.newServer(func(data []byte, _ inputsource.NetworkMetadata) {
+		events <- r.build(ParseLog(string(data)))
+	})
+	if !assert.NoError(t, err) || !assert.NoError(t, s.Start()) {
+		return
+	}
+	defer s.Stop()
+
+	conn, err := net.Dial("udp", s.(*udp.Server).Listener.LocalAddr().String())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte("<54>Apr  8 12:00:00 sepm01 SymantecServer: Virus found,Computer name: host1,Risk name: EICAR\n"))
+	assert.NoError(t, err)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "SEPM RISK", event.Fields["event_type"])
+		assert.Equal(t, "host1", event.Fields["device_name"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+}
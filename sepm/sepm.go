@@ -0,0 +1,259 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sepm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// MaxBatchSize is the largest page size requested from SEPM.
+const MaxBatchSize = 1000
+
+const (
+	computersCheckpointKey      = "checkpoint/computers"
+	criticalEventsCheckpointKey = "checkpoint/critical_events"
+	commandsCheckpointKey       = "checkpoint/commands"
+)
+
+// Config configures the on-premises SEPM collector.
+type Config struct {
+	Enabled  bool              `config:"enabled"`
+	URL      string            `config:"url"`
+	Username string            `config:"username"`
+	Password client.Secret     `config:"password"`
+	Domain   string            `config:"domain"`
+	SSL      *tlscommon.Config `config:"ssl"`
+	Period   time.Duration     `config:"period"`
+	// BatchSize is the page size of the paginated endpoints.
+	BatchSize int `config:"batch_size"`
+	// StartDate is how far back to look on the first run, and how far back
+	// commands are checked for status updates.
+	StartDate time.Duration `config:"start_date"`
+}
+
+// DefaultConfig polls SEPM every 5 minutes.
+var DefaultConfig = Config{
+	Period:    5 * time.Minute,
+	BatchSize: 100,
+	StartDate: 24 * time.Hour,
+}
+
+// Validate checks that an enabled collector knows where and how to log in.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("sepm.period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > MaxBatchSize {
+		return fmt.Errorf("sepm.batch_size must be between 1 and %d, got %d", MaxBatchSize, c.BatchSize)
+	}
+	if c.URL == "" {
+		return errors.New("sepm.url is required")
+	}
+	if c.Username == "" || c.Password == "" {
+		return errors.New("sepm.username and sepm.password are required")
+	}
+	return nil
+}
+
+// Collector polls the computers, critical events and command statuses of a
+// SEPM server. Each of them has its own checkpoint in the registry, read once
+// and then kept in memory. The registry is updated as the events are
+// acknowledged.
+type Collector struct {
+	config      Config
+	client      *Client
+	store       *registry.Store
+	checkpoints map[string]time.Time
+	build       client.EventBuilder
+	logger      *logp.Logger
+}
+
+// NewCollector creates a Collector keeping its checkpoints in store.
+func NewCollector(config Config, store *registry.Store, build client.EventBuilder) (*Collector, error) {
+	tlsConfig, err := client.LoadTLSConfig(config.SSL, config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Collector{
+		config:      config,
+		client:      NewClient(config.URL, config.Username, config.Password, config.Domain, tlsConfig),
+		store:       store,
+		checkpoints: map[string]time.Time{},
+		build:       build,
+		logger:      logp.NewLogger("sepm"),
+	}, nil
+}
+
+// Collect runs every sub collection up to now. A failing one does not prevent the
+// others from running.
+func (c *Collector) Collect(out input.Outlet, now time.Time) error {
+	failed := 0
+	for _, sub := range []struct {
+		name    string
+		collect func(input.Outlet, time.Time) error
+	}{
+		{"computers", c.collectComputers},
+		{"critical events", c.collectCriticalEvents},
+		{"command statuses", c.collectCommands},
+	} {
+		if err := sub.collect(out, now); err != nil {
+			c.logger.Errorf("Error collecting SEPM %s err=%s", sub.name, err.Error())
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d SEPM collections failed", failed)
+	}
+	return nil
+}
+
+// checkpoint returns the checkpoint of key, or the start date when there is
+// none yet.
+func (c *Collector) checkpoint(key string, now time.Time) (time.Time, error) {
+	if checkpoint, ok := c.checkpoints[key]; ok {
+		return checkpoint, nil
+	}
+	checkpoint := now.Add(-c.config.StartDate)
+	_, err := c.store.Get(key, &checkpoint)
+	return checkpoint, err
+}
+
+// advance moves the checkpoint of key to latest once the events published
+// before are acknowledged.
+func (c *Collector) advance(out input.Outlet, key string, latest time.Time) {
+	c.checkpoints[key] = latest
+	out.Checkpoint(input.Checkpoint{Key: key, Value: latest})
+}
+
+func (c *Collector) collectComputers(out input.Outlet, now time.Time) error {
+	since, err := c.checkpoint(computersCheckpointKey, now)
+	if err != nil {
+		return err
+	}
+	var computers []Computer
+	err = c.client.GetComputers(since, c.config.BatchSize, func(page []Computer) error {
+		if out.Stopped() {
+			return input.ErrStopped
+		}
+		computers = append(computers, page...)
+		return nil
+	})
+	if err == input.ErrStopped {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	latest := since
+	for _, computer := range computers {
+		updated := computer.LastUpdateTime.Time()
+		if !updated.After(since) {
+			continue
+		}
+		if !out.Publish(c.build.Rebuild(client.SEPM_COMPUTER, computerEvent(computer))) {
+			return nil
+		}
+		if updated.After(latest) {
+			latest = updated
+		}
+	}
+	c.advance(out, computersCheckpointKey, latest)
+	return nil
+}
+
+func (c *Collector) collectCriticalEvents(out input.Outlet, now time.Time) error {
+	since, err := c.checkpoint(criticalEventsCheckpointKey, now)
+	if err != nil {
+		return err
+	}
+	events, err := c.client.GetCriticalEvents()
+	if err != nil {
+		return err
+	}
+
+	latest := since
+	for _, e := range events {
+		ts, err := e.Time()
+		if err != nil {
+			c.logger.Errorf("dropping critical event %s with invalid time err=%s", e.ID, err.Error())
+			continue
+		}
+		if !ts.After(since) {
+			continue
+		}
+		if !out.Publish(c.build.Rebuild(client.SEPM_CRITICAL_EVENT, criticalEvent(e, ts))) {
+			return nil
+		}
+		if ts.After(latest) {
+			latest = ts
+		}
+	}
+	c.advance(out, criticalEventsCheckpointKey, latest)
+	return nil
+}
+
+func (c *Collector) collectCommands(out input.Outlet, now time.Time) error {
+	since, err := c.checkpoint(commandsCheckpointKey, now)
+	if err != nil {
+		return err
+	}
+	var commands []Command
+	err = c.client.GetCommands(now.Add(-c.config.StartDate), now, c.config.BatchSize, func(page []Command) error {
+		if out.Stopped() {
+			return input.ErrStopped
+		}
+		commands = append(commands, page...)
+		return nil
+	})
+	if err == input.ErrStopped {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	latest := since
+	for _, command := range commands {
+		updated := command.LastUpdateTime.Time()
+		if !updated.After(since) {
+			continue
+		}
+		if !out.Publish(c.build.Rebuild(client.SEPM_COMMAND, commandEvent(command))) {
+			return nil
+		}
+		if updated.After(latest) {
+			latest = updated
+		}
+	}
+	c.advance(out, commandsCheckpointKey, latest)
+	return nil
+}
@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package sepm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func newTestCollector(t *testing.T, url string) (*Collector, func()) {
+	store, cleanup := inputtest.NewStore(t, "sepm")
+
+	config := DefaultConfig
+	config.Enabled = true
+	config.URL = url
+	config.Username = "admin"
+	config.Password = "secret"
+	c, err := NewCollector(config, store, inputtest.Build)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return c, cleanup
+}
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	now := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	updated := now.Add(-time.Hour).UnixNano() / int64(time.Millisecond)
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != authenticateURL && r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case authenticateURL:
+			fmt.Fprint(w, `{"token":"token","tokenExpiration":43200}`)
+		case computersURL:
+			page := r.URL.Query().Get("pageIndex")
+			pages = append(pages, page)
+			fmt.Fprintf(w, `{"lastPage":%t,"totalPages":2,"content":[{"uniqueId":"c%s","computerName":"host%s",`+
+				`"ipAddresses":["10.0.0.%s"],"operatingSystem":"Windows 10","group":{"id":"g1","name":"My Company"},`+
+				`"agentVersion":"14.3","onlineStatus":1,"lastUpdateTime":"%d"}]}`, page == "2", page, page, page, updated)
+		case criticalEventURL:
+			fmt.Fprint(w, `{"criticalEventsInfoList":[`+
+				`{"eventId":"e1","eventDateTime":"2020-04-08 11:30:00.0","subject":"Virus found","message":"EICAR"},`+
+				`{"eventId":"e0","eventDateTime":"2020-04-01 11:30:00.0","subject":"Old","message":"old"}]}`)
+		case commandQueueURL:
+			fmt.Fprintf(w, `{"lastPage":true,"totalPages":1,"content":[{"commandId":"cmd1","computerId":"c1",`+
+				`"computerName":"host1","stateId":3,"subStateDesc":"Completed","lastUpdateTime":%d}]}`, updated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, cleanup := newTestCollector(t, server.URL)
+	defer cleanup()
+	p := inputtest.NewOutlet(c.store)
+
+	a.NoError(c.Collect(p, now))
+	a.Equal([]string{"1", "2"}, pages)
+	if !a.Len(p.Events, 4) {
+		return
+	}
+
+	computer := p.Events[0].Fields
+	a.Equal(ComputerEventType, computer["event_type"])
+	a.Equal("sepm_computer", p.Events[0].Meta["pipeline"])
+	a.Equal("c1", computer["device_uid"])
+	a.Equal("10.0.0.1", computer["device_ip"])
+	a.Equal("My Company", computer["device_group"])
+	online, _ := computer.GetValue("sepm.computer.online")
+	a.Equal(true, online)
+
+	critical := p.Events[2]
+	a.Equal(CriticalEventType, critical.Fields["event_type"])
+	a.Equal("sepm_critical_event", critical.Meta["pipeline"])
+	a.Equal("e1", critical.Fields["uuid"])
+	a.Equal(time.Date(2020, 4, 8, 11, 30, 0, 0, time.UTC), critical.Timestamp)
+
+	command := p.Events[3].Fields
+	a.Equal(CommandEventType, command["event_type"])
+	a.Equal("sepm_command", p.Events[3].Meta["pipeline"])
+	a.Equal("host1", command["device_name"])
+	a.Equal(3, command["status_id"])
+
+	// Nothing changed since the checkpoints.
+	a.NoError(c.Collect(p, now.Add(time.Minute)))
+	a.Len(p.Events, 4)
+}
+
+func TestAuthenticateAgainWhenTokenRejected(t *testing.T) {
+	a := assert.New(t)
+
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == authenticateURL:
+			logins++
+			fmt.Fprintf(w, `{"token":"token%d","tokenExpiration":43200}`, logins)
+		case r.Header.Get("Authorization") != "Bearer token2":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			fmt.Fprint(w, `{"criticalEventsInfoList":[]}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "admin", "secret", "", nil)
+	events, err := client.GetCriticalEvents()
+	a.NoError(err)
+	a.Empty(events)
+	a.Equal(2, logins)
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.URL = "https://sepm.example.com:8446"
+	config.Username = "admin"
+	config.Password = "secret"
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"sepm.period", func(c *Config) { c.Period = 0 }},
+		{"sepm.period", func(c *Config) { c.Period = -time.Minute }},
+		{"sepm.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"sepm.batch_size", func(c *Config) { c.BatchSize = MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
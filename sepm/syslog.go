@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sepm
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+	"github.com/elastic/beats/libbeat/common/cfgtype"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+)
+
+// SyslogConfig configures the listener receiving the logs SEPM sends to a
+// syslog server.
+type SyslogConfig struct {
+	Enabled bool `config:"enabled"`
+	// Protocol is udp or tcp. TCP messages are separated by new lines.
+	Protocol       string                  `config:"protocol"`
+	Host           string                  `config:"host"`
+	MaxMessageSize cfgtype.ByteSize        `config:"max_message_size"`
+	Timeout        time.Duration           `config:"timeout"`
+	SSL            *tlscommon.ServerConfig `config:"ssl"`
+}
+
+// DefaultSyslogConfig listens on the syslog UDP port.
+var DefaultSyslogConfig = SyslogConfig{
+	Protocol:       "udp",
+	Host:           "localhost:514",
+	MaxMessageSize: 10 * 1024,
+	Timeout:        5 * time.Minute,
+}
+
+// Validate checks the protocol and the listening address.
+func (c *SyslogConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Protocol != "udp" && c.Protocol != "tcp" {
+		return fmt.Errorf("sepm.syslog.protocol must be udp or tcp, got '%s'", c.Protocol)
+	}
+	if c.Host == "" {
+		return fmt.Errorf("sepm.syslog.host is required")
+	}
+	return nil
+}
+
+type server interface {
+	Start() error
+	Stop()
+}
+
+// Receiver listens for SEPM syslog messages and publishes them as they
+// arrive.
+type Receiver struct {
+	config SyslogConfig
+	build  client.EventBuilder
+	logger *logp.Logger
+}
+
+// NewReceiver creates a Receiver.
+func NewReceiver(config SyslogConfig, build client.EventBuilder) *Receiver {
+	return &Receiver{
+		config: config,
+		build:  build,
+		logger: logp.NewLogger("sepm_syslog"),
+	}
+}
+
+// Run listens until out is done.
+func (r *Receiver) Run(out input.Outlet) {
+	s, err := r.newServer(func(data []byte, _ inputsource.NetworkMetadata) {
+		out.Publish(r.build(ParseLog(string(data))))
+	})
+	if err != nil {
+		r.logger.Errorf("Error creating the SEPM syslog listener err=%s", err.Error())
+		return
+	}
+	if err := s.Start(); err != nil {
+		r.logger.Errorf("Error starting the SEPM syslog listener on %s err=%s", r.config.Host, err.Error())
+		return
+	}
+	r.logger.Infof("Receiving SEPM logs on %s/%s", r.config.Protocol, r.config.Host)
+
+	<-out.Done()
+	s.Stop()
+}
+
+func (r *Receiver) newServer(callback inputsource.NetworkFunc) (server, error) {
+	if r.config.Protocol == "tcp" {
+		return tcp.New(&tcp.Config{
+			Host:           r.config.Host,
+			Timeout:        r.config.Timeout,
+			MaxMessageSize: r.config.MaxMessageSize,
+			TLS:            r.config.SSL,
+		}, tcp.SplitHandlerFactory(callback, bufio.ScanLines))
+	}
+	return udp.New(&udp.Config{
+		Host:           r.config.Host,
+		Timeout:        r.config.Timeout,
+		MaxMessageSize: r.config.MaxMessageSize,
+	}, callback), nil
+}
@@ -0,0 +1,174 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ses
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// lastRunKey is followed by the name of the event type. Without the event
+// type, it is the checkpoint shared by all the types before they had their
+// own.
+const lastRunKey = "checkpoint/last_run"
+
+func init() {
+	if err := input.Register("ses_events", newInput); err != nil {
+		panic(err)
+	}
+	if err := input.RegisterSettings("ses_events", DefaultConfig); err != nil {
+		panic(err)
+	}
+}
+
+// Config configures the SES event export collector.
+type Config struct {
+	Period    time.Duration `config:"period"`
+	BatchSize int           `config:"batch_size"`
+	// StartDate is how far back to export on the first run.
+	StartDate time.Duration `config:"start_date"`
+}
+
+// DefaultConfig exports the events every 5 minutes.
+var DefaultConfig = Config{
+	Period:    5 * time.Minute,
+	BatchSize: 1000,
+	StartDate: 60 * time.Minute,
+}
+
+// Validate checks the export settings.
+func (c *Config) Validate() error {
+	if c.Period <= 0 {
+		return fmt.Errorf("period must be positive, got %v", c.Period)
+	}
+	if c.BatchSize < 1 || c.BatchSize > client.MaxBatchSize {
+		return fmt.Errorf("batch_size must be between 1 and %d, got %d", client.MaxBatchSize, c.BatchSize)
+	}
+	if c.StartDate < 0 {
+		return fmt.Errorf("start_date cannot be negative, got %v", c.StartDate)
+	}
+	return nil
+}
+
+// Collector exports the SES events of every type each period. The end of
+// the last successful export of every type is kept in the registry, and moved
+// once its events are acknowledged. The checkpoints are read from the
+// registry on the first export and then kept in memory.
+type Collector struct {
+	config   Config
+	smClient client.SymantecClient
+	store    *registry.Store
+	build    client.EventBuilder
+	lastRun  map[client.EventType]time.Time
+	logger   *logp.Logger
+}
+
+func newInput(cfg *common.Config, ctx input.Context) (input.Collector, error) {
+	config := DefaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	c := NewCollector(config, ctx.Client, ctx.Store, ctx.Build)
+	return input.NewPeriodic(config.Period, c, c.logger), nil
+}
+
+func typeLastRunKey(t client.EventType) string {
+	return lastRunKey + "/" + t.Name()
+}
+
+// NewCollector creates a Collector resuming from the last exports kept in
+// store. It works on its own copy of the SES client.
+func NewCollector(config Config, smClient client.SymantecClient, store *registry.Store, build client.EventBuilder) *Collector {
+	return &Collector{
+		config:   config,
+		smClient: smClient,
+		store:    store,
+		build:    build,
+		logger:   logp.NewLogger("ses"),
+	}
+}
+
+// load reads the last exports from the registry. The types without their own
+// checkpoint resume from the shared one of the previous versions, or from the
+// start date.
+func (c *Collector) load(end time.Time) error {
+	shared := end.Add(-1 * c.config.StartDate)
+	if _, err := c.store.Get(lastRunKey, &shared); err != nil {
+		return err
+	}
+	lastRun := map[client.EventType]time.Time{}
+	for _, t := range client.AllTypes {
+		typeLastRun := shared
+		if _, err := c.store.Get(typeLastRunKey(t), &typeLastRun); err != nil {
+			return err
+		}
+		lastRun[t] = typeLastRun
+	}
+	c.lastRun = lastRun
+	return nil
+}
+
+// Collect exports the events from the last export up to end.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	if c.lastRun == nil {
+		if err := c.load(end); err != nil {
+			return err
+		}
+	}
+	if err := c.smClient.GetOauthToken(); err != nil {
+		return fmt.Errorf("error getting the access token, check the credentials: %v", err)
+	}
+
+	failed := 0
+	for _, t := range client.AllTypes {
+		var mapStrArr []common.MapStr
+		err := c.smClient.Export(c.lastRun[t], end, t, c.config.BatchSize, func(mapStr common.MapStr) error {
+			if out.Stopped() {
+				return input.ErrStopped
+			}
+			mapStrArr = append(mapStrArr, mapStr)
+			return nil
+		})
+		if err == input.ErrStopped {
+			return nil
+		}
+		if err != nil {
+			c.logger.Errorf("Error exporting the %s events, retrying them on the next run err=%s", t.Name(), err.Error())
+			failed++
+			continue
+		}
+		for _, mapStr := range mapStrArr {
+			if !out.Publish(c.build(t, mapStr)) {
+				return nil
+			}
+		}
+		c.lastRun[t] = end
+		out.Checkpoint(input.Checkpoint{Key: typeLastRunKey(t), Value: end})
+	}
+	if failed > 0 {
+		return fmt.Errorf("the export of %d event types failed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package ses
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	exported := false
+	server, sm := inputtest.NewSESServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sccs/v1/events/export":
+			var request map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&request)
+			if request["type"] == client.FIREWALL.String() {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if exported {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			exported = true
+			fmt.Fprint(w, `[{"feature_name":"MALWARE_PROTECTION","type_id":8031}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	var types []client.EventType
+	build := func(t client.EventType, fields common.MapStr) beat.Event {
+		types = append(types, t)
+		return beat.Event{Fields: fields}
+	}
+	dir, err := ioutil.TempDir("", "ses")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ses_events.json")
+	store, err := registry.OpenFile(path)
+	a.NoError(err)
+
+	c := NewCollector(DefaultConfig, sm, store, build)
+	p := inputtest.NewOutlet(store)
+
+	// The failing firewall export does not move its checkpoint.
+	end := time.Now().UTC()
+	a.Error(c.Collect(p, end))
+	a.Len(p.Events, 1)
+	a.Len(types, 1)
+	a.Equal(end, c.lastRun[client.MALWARE_PROTECTION])
+	a.True(c.lastRun[client.FIREWALL].Before(end))
+	a.NotContains(store.Keys(), "checkpoint/last_run/firewall")
+
+	// A new collector resumes from the last exports.
+	store, err = registry.OpenFile(path)
+	a.NoError(err)
+	c = NewCollector(DefaultConfig, sm, store, build)
+	a.NoError(c.load(end))
+	a.True(end.Equal(c.lastRun[client.MALWARE_PROTECTION]))
+	a.True(c.lastRun[client.FIREWALL].Before(end))
+
+	// The types without their own checkpoint resume from the shared one.
+	shared := end.Add(-time.Minute)
+	a.NoError(store.Set(lastRunKey, shared))
+	c = NewCollector(DefaultConfig, sm, store, build)
+	a.NoError(c.load(end))
+	a.True(shared.Equal(c.lastRun[client.FIREWALL]))
+	a.True(end.Equal(c.lastRun[client.MALWARE_PROTECTION]))
+}
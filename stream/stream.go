@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stream
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+// Config configures the event stream collector.
+type Config struct {
+	ID        string              `config:"stream_id"`
+	Channel   string              `config:"channel"`
+	Wait      time.Duration       `config:"wait"`
+	BatchSize int                 `config:"batch_size"`
+	Backoff   input.BackoffConfig `config:"backoff"`
+}
+
+// DefaultConfig long-polls for up to 30 seconds.
+var DefaultConfig = Config{
+	Channel:   "0",
+	Wait:      30 * time.Second,
+	BatchSize: 1000,
+	Backoff:   input.DefaultBackoff,
+}
+
+// Validate checks the page size of the stream reads.
+func (c *Config) Validate() error {
+	if c.BatchSize < 1 || c.BatchSize > client.MaxBatchSize {
+		return fmt.Errorf("stream.batch_size must be between 1 and %d, got %d", client.MaxBatchSize, c.BatchSize)
+	}
+	if c.Wait < 0 {
+		return fmt.Errorf("stream.wait cannot be negative, got %v", c.Wait)
+	}
+	return nil
+}
+
+// Collector reads an event stream channel and publishes the events as they
+// arrive. The offset of the channel is kept in the registry, and moved as the
+// events are acknowledged.
+type Collector struct {
+	config   Config
+	smClient client.SymantecClient
+	store    *registry.Store
+	build    client.EventBuilder
+	logger   *logp.Logger
+}
+
+// NewCollector creates a Collector. It works on its own copy of the SES client.
+func NewCollector(config Config, smClient client.SymantecClient, store *registry.Store, build client.EventBuilder) *Collector {
+	return &Collector{
+		config:   config,
+		smClient: smClient,
+		store:    store,
+		build:    build,
+		logger:   logp.NewLogger("stream"),
+	}
+}
+
+func (c *Collector) offsetKey() string {
+	return "offset/" + c.config.ID + "/" + c.config.Channel
+}
+
+// Run reads the stream until out is done, reconnecting with a backoff
+// whenever the connection fails.
+func (c *Collector) Run(out input.Outlet) {
+	var offset string
+	if _, err := c.store.Get(c.offsetKey(), &offset); err != nil {
+		c.logger.Errorf("Error reading the stream offset, reading from the start err=%s", err.Error())
+	}
+
+	b := c.config.Backoff.New(out.Done())
+	connected := false
+	for !out.Stopped() {
+		if !connected {
+			if err := c.smClient.GetOauthToken(); err != nil {
+				c.logger.Errorf("Error connecting to the event stream err=%s", err.Error())
+				b.Wait()
+				continue
+			}
+			connected = true
+		}
+
+		next, err := c.read(out, offset)
+		if err != nil {
+			c.logger.Errorf("Event stream disconnected, reconnecting err=%s", err.Error())
+			connected = false
+			b.Wait()
+			continue
+		}
+		b.Reset()
+		offset = next
+	}
+}
+
+// read long-polls one batch and publishes it. The next offset is stored once
+// the events of the batch are acknowledged.
+func (c *Collector) read(out input.Outlet, offset string) (string, error) {
+	batch, err := c.smClient.ReadStream(c.config.ID, c.config.Channel, offset, c.config.Wait, c.config.BatchSize)
+	if err != nil {
+		return offset, err
+	}
+
+	for _, e := range batch.Events {
+		if !out.Publish(c.build(e.Type, e.Fields)) {
+			return offset, nil
+		}
+	}
+
+	if batch.Next != offset {
+		out.Checkpoint(input.Checkpoint{Key: c.offsetKey(), Value: batch.Next})
+	}
+	return batch.Next, nil
+}
@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package stream
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+func TestReadPublishesAndStoresOffset(t *testing.T) {
+	a := assert.New(t)
+
+	var offsets []string
+	server, sm := inputtest.NewSESServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sccs/v1/streams/s1/channels/0":
+			offsets = append(offsets, r.URL.Query().Get("offset"))
+			fmt.Fprint(w, `{"next":"o2","events":[`+
+				`{"feature_name":"MALWARE_PROTECTION","type_id":8031},`+
+				`{"feature_name":"SOMETHING_NEW","type_id":1}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "stream")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.ID = "s1"
+	var types []client.EventType
+	build := func(t client.EventType, fields common.MapStr) beat.Event {
+		types = append(types, t)
+		return beat.Event{Fields: fields}
+	}
+	a.NoError(sm.GetOauthToken())
+	c := NewCollector(config, sm, store, build)
+	p := inputtest.NewOutlet(store)
+
+	next, err := c.read(p, "o1")
+	a.NoError(err)
+	a.Equal("o2", next)
+	a.Equal([]string{"o1"}, offsets)
+	a.Len(p.Events, 2)
+	a.Equal([]client.EventType{client.MALWARE_PROTECTION, client.UNKNOWN}, types)
+	eventType, _ := p.Events[0].Fields.GetValue("event_type")
+	a.Equal("MALWARE PROTECTION", eventType)
+
+	var offset string
+	found, err := store.Get(c.offsetKey(), &offset)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("o2", offset)
+}
+
+func TestReadFailsOnServerError(t *testing.T) {
+	a := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "stream")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.ID = "s1"
+	c := NewCollector(config, client.NewSymantecClient(server.URL, "", "", "id", "secret"), store, nil)
+
+	next, err := c.read(inputtest.NewOutlet(store), "o1")
+	a.Error(err)
+	a.Equal("o1", next)
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"stream.batch_size", func(c *Config) { c.BatchSize = 0 }},
+		{"stream.batch_size", func(c *Config) { c.BatchSize = client.MaxBatchSize + 1 }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
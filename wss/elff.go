@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wss
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	// EventType is the event_type of the WSS access log events.
+	EventType = "WSS ACCESS"
+
+	productName = "Symantec Web Security Service"
+
+	elffTimeFormat = "2006-01-02 15:04:05"
+)
+
+// DefaultFields is the field list of the WSS access logs, used until a
+// #Fields directive is read.
+var DefaultFields = strings.Fields(`date time time-taken c-ip cs-username cs-auth-group
+	x-exception-id sc-filter-result cs-categories cs(Referer) sc-status s-action cs-method
+	rs(Content-Type) cs-uri-scheme cs-host cs-uri-port cs-uri-path cs-uri-query
+	cs-uri-extension cs(User-Agent) s-ip sc-bytes cs-bytes x-virus-id
+	x-bluecoat-application-name s-supplier-ip`)
+
+// Parser parses the W3C extended log format lines of an access log file. The
+// field list is taken from the #Fields directive of the file.
+type Parser struct {
+	fields []string
+}
+
+// Parse parses a line into fields in the SES layout. Directives, empty lines
+// and lines not matching the field list are skipped and return false.
+func (p *Parser) Parse(line string) (common.MapStr, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil, false
+	}
+	if strings.HasPrefix(line, "#") {
+		if strings.HasPrefix(line, "#Fields:") {
+			p.fields = strings.Fields(strings.TrimPrefix(line, "#Fields:"))
+		}
+		return nil, false
+	}
+
+	names := p.fields
+	if names == nil {
+		names = DefaultFields
+	}
+	values := splitELFF(line)
+	if len(values) != len(names) {
+		return nil, false
+	}
+
+	record := make(map[string]string, len(names))
+	for i, name := range names {
+		if values[i] != "-" && values[i] != "" {
+			record[name] = values[i]
+		}
+	}
+	return newFields(record), true
+}
+
+// splitELFF splits a line on spaces, keeping the double quoted values, which
+// may contain spaces, together.
+func splitELFF(line string) []string {
+	var values []string
+	for len(line) > 0 {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			break
+		}
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				values = append(values, line[1:])
+				break
+			}
+			values = append(values, line[1:end+1])
+			line = line[end+2:]
+			continue
+		}
+		end := strings.IndexAny(line, " \t")
+		if end < 0 {
+			values = append(values, line)
+			break
+		}
+		values = append(values, line[:end])
+		line = line[end:]
+	}
+	return values
+}
+
+// newFields maps an access log record to the SES web layout. The fields
+// without SES equivalent are kept under wss.
+func newFields(r map[string]string) common.MapStr {
+	ts, err := time.Parse(elffTimeFormat, r["date"]+" "+r["time"])
+	if err != nil {
+		ts = time.Now().UTC()
+	}
+	fields := common.MapStr{
+		"event_type":   EventType,
+		"time":         ts.Format(time.RFC3339Nano),
+		"product_name": productName,
+	}
+
+	put := func(key, value string) {
+		if value != "" {
+			fields.Put(key, value)
+		}
+	}
+	putInt := func(key, value string) {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			fields.Put(key, i)
+		}
+	}
+
+	put("device_ip", r["c-ip"])
+	put("device_name", r["x-client-device-name"])
+	put("user_name", r["cs-username"])
+
+	put("connection.src_ip", r["c-ip"])
+	put("connection.dst_ip", r["s-supplier-ip"])
+	putInt("connection.dst_port", r["cs-uri-port"])
+	putInt("connection.bytes_in", r["sc-bytes"])
+	putInt("connection.bytes_out", r["cs-bytes"])
+
+	put("url.scheme", r["cs-uri-scheme"])
+	put("url.host", r["cs-host"])
+	putInt("url.port", r["cs-uri-port"])
+	put("url.path", r["cs-uri-path"])
+	if r["cs-host"] != "" {
+		put("url.text", urlText(r))
+	}
+	put("http_request.method", r["cs-method"])
+	put("http_request.user_agent", r["cs(User-Agent)"])
+	put("http_request.referrer", r["cs(Referer)"])
+
+	put("threat.name", r["x-virus-id"])
+
+	put("wss.action", r["s-action"])
+	put("wss.filter_result", r["sc-filter-result"])
+	putInt("wss.status", r["sc-status"])
+	putInt("wss.time_taken", r["time-taken"])
+	put("wss.exception_id", r["x-exception-id"])
+	put("wss.auth_group", r["cs-auth-group"])
+	put("wss.application", r["x-bluecoat-application-name"])
+	put("wss.content_type", r["rs(Content-Type)"])
+	if categories := r["cs-categories"]; categories != "" {
+		fields.Put("wss.categories", strings.Split(categories, ";"))
+	}
+	return fields
+}
+
+// urlText rebuilds the requested URL.
+func urlText(r map[string]string) string {
+	var b strings.Builder
+	if scheme := r["cs-uri-scheme"]; scheme != "" {
+		b.WriteString(scheme + "://")
+	}
+	b.WriteString(r["cs-host"])
+	if port := r["cs-uri-port"]; port != "" && port != "80" && port != "443" {
+		b.WriteString(":" + port)
+	}
+	b.WriteString(r["cs-uri-path"])
+	if query := r["cs-uri-query"]; query != "" {
+		if !strings.HasPrefix(query, "?") {
+			b.WriteString("?")
+		}
+		b.WriteString(query)
+	}
+	return b.String()
+}
@@ -0,0 +1,249 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wss
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input"
+	"github.com/marian-craciunescu/symantecbeat/registry"
+)
+
+const (
+	checkpointKey = "checkpoint"
+
+	// Values of the X-sync-status header.
+	syncMore  = "more"
+	syncDone  = "done"
+	syncAbort = "abort"
+
+	// maxLineSize is the longest access log line accepted.
+	maxLineSize = 1024 * 1024
+)
+
+// Config configures the WSS access log sync collector.
+type Config struct {
+	Enabled  bool          `config:"enabled"`
+	URL      string        `config:"url"`
+	Username string        `config:"username"`
+	Password client.Secret `config:"password"`
+	Period   time.Duration `config:"period"`
+	// StartDate is how far back to sync on the first run.
+	StartDate time.Duration `config:"start_date"`
+	// Timeout is the timeout of a single sync request, archive download
+	// included.
+	Timeout time.Duration `config:"timeout"`
+}
+
+// DefaultConfig syncs the access logs every 5 minutes.
+var DefaultConfig = Config{
+	URL:       "https://portal.threatpulse.com/reportpod/logs/sync",
+	Period:    5 * time.Minute,
+	StartDate: time.Hour,
+	Timeout:   10 * time.Minute,
+}
+
+// Validate checks that an enabled collector has credentials and a period.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Username == "" || c.Password == "" {
+		return errors.New("wss.username and wss.password are required")
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("wss.period must be positive, got %v", c.Period)
+	}
+	return nil
+}
+
+// checkpoint is the position in the sync API kept in the registry. The token
+// is only valid together with the start date it was issued for.
+type checkpoint struct {
+	StartDate int64  `json:"start_date"`
+	Token     string `json:"token"`
+}
+
+// Collector downloads the WSS access logs through the log sync API and
+// publishes every access log line. The checkpoint is read from the registry
+// once and then kept in memory, the registry being updated as the events are
+// acknowledged.
+type Collector struct {
+	config     Config
+	store      *registry.Store
+	build      client.EventBuilder
+	httpClient *http.Client
+	checkpoint *checkpoint
+	logger     *logp.Logger
+}
+
+// NewCollector creates a Collector keeping its checkpoint in store.
+func NewCollector(config Config, store *registry.Store, build client.EventBuilder) *Collector {
+	return &Collector{
+		config:     config,
+		store:      store,
+		build:      build,
+		httpClient: &http.Client{Timeout: config.Timeout},
+		logger:     logp.NewLogger("wss"),
+	}
+}
+
+// Collect downloads archives until the sync API reports it is done, or until
+// an archive has access logs from end on. The sync is not bounded, so the
+// last archive may go past end. The checkpoint moves after every archive.
+func (c *Collector) Collect(out input.Outlet, end time.Time) error {
+	if c.checkpoint == nil {
+		cp := checkpoint{StartDate: end.Add(-c.config.StartDate).UnixNano() / int64(time.Millisecond)}
+		if _, err := c.store.Get(checkpointKey, &cp); err != nil {
+			return err
+		}
+		c.checkpoint = &cp
+	}
+
+	for {
+		status, token, latest, err := c.sync(out, *c.checkpoint)
+		if err != nil {
+			return err
+		}
+		if token != "" {
+			c.checkpoint.Token = token
+		}
+		if !out.Checkpoint(input.Checkpoint{Key: checkpointKey, Value: *c.checkpoint}) {
+			return nil
+		}
+
+		switch status {
+		case syncMore:
+			if !latest.Before(end) {
+				return nil
+			}
+		case syncAbort:
+			return errors.New("WSS aborted the log sync")
+		default:
+			return nil
+		}
+
+		if out.Stopped() {
+			return nil
+		}
+	}
+}
+
+// sync downloads and publishes one archive. It returns the sync status, the
+// token to continue from and the time of the latest access log.
+func (c *Collector) sync(out input.Outlet, cp checkpoint) (string, string, time.Time, error) {
+	params := url.Values{}
+	params.Set("startDate", strconv.FormatInt(cp.StartDate, 10))
+	params.Set("endDate", "0")
+	params.Set("token", cp.Token)
+	if cp.Token == "" {
+		params.Set("token", "none")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.config.URL+"?"+params.Encode(), nil)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	req.Header.Add("X-APIUsername", c.config.Username)
+	req.Header.Add("X-APIPassword", string(c.config.Password))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", time.Time{}, fmt.Errorf("WSS log sync returned %s", resp.Status)
+	}
+
+	// The archive is read as it is downloaded, the entries being published
+	// one after the other. An archive failing halfway is downloaded again
+	// with the same token, so its first events may be published twice.
+	status := resp.Header.Get("X-sync-status")
+	token := resp.Header.Get("X-sync-token")
+	archive := newZipStream(resp.Body)
+	var latest time.Time
+	events, files := 0, 0
+	for !out.Stopped() {
+		name, r, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", "", time.Time{}, fmt.Errorf("error reading the WSS log archive: %v", err)
+		}
+		n, fileLatest, err := c.publishFile(out, name, r)
+		if err != nil {
+			return "", "", time.Time{}, fmt.Errorf("error reading %s from the WSS log archive: %v", name, err)
+		}
+		events += n
+		if fileLatest.After(latest) {
+			latest = fileLatest
+		}
+		files++
+	}
+	c.logger.Infof("Got %d access log events from %d files, sync status=%s", events, files, status)
+	return status, token, latest, nil
+}
+
+// publishFile publishes the lines of a log file of the archive, which may be
+// gzip compressed. It returns the number of events and the time of the latest
+// one.
+func (c *Collector) publishFile(out input.Outlet, name string, r io.Reader) (int, time.Time, error) {
+	var latest time.Time
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return 0, latest, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	parser := &Parser{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	events := 0
+	for scanner.Scan() {
+		fields, ok := parser.Parse(scanner.Text())
+		if !ok {
+			continue
+		}
+		value, _ := fields["time"].(string)
+		if ts, err := time.Parse(time.RFC3339Nano, value); err == nil && ts.After(latest) {
+			latest = ts
+		}
+		if !out.Publish(c.build(client.WEB_SECURITY, fields)) {
+			return events, latest, nil
+		}
+		events++
+	}
+	return events, latest, scanner.Err()
+}
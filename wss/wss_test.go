@@ -0,0 +1,258 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package wss
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/marian-craciunescu/symantecbeat/client"
+	"github.com/marian-craciunescu/symantecbeat/input/inputtest"
+)
+
+const accessLog = `#Software: SGOS 6.7
+#Fields: date time time-taken c-ip cs-username cs-categories sc-status s-action cs-method cs-uri-scheme cs-host cs-uri-port cs-uri-path cs-uri-query cs(User-Agent) sc-bytes cs-bytes x-virus-id
+2020-04-08 11:59:00 120 10.0.0.5 bob "Technology/Internet;Web Ads/Analytics" 200 TCP_NC_MISS GET https www.example.com 443 /index.html ?q=1 "Mozilla/5.0 (Windows NT 10.0)" 5120 480 -
+2020-04-08 11:59:01 15 10.0.0.5 bob "Malicious Sources" 403 TCP_DENIED GET http evil.example.com 8080 /payload.exe - - 0 200 EICAR
+`
+
+func archive(t *testing.T, name, content string) []byte {
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write([]byte(content))
+	w.Close()
+
+	var buf bytes.Buffer
+	z := zip.NewWriter(&buf)
+	f, err := z.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write(gz.Bytes())
+	if err := z.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	var tokens []string
+	body := archive(t, "cloud_1.log.gz", accessLog)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-APIUsername") != "user" || r.Header.Get("X-APIPassword") != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		token := r.URL.Query().Get("token")
+		tokens = append(tokens, token)
+		switch token {
+		case "none":
+			w.Header().Set("X-sync-status", "more")
+			w.Header().Set("X-sync-token", "t1")
+			w.Write(body)
+		default:
+			w.Header().Set("X-sync-status", "done")
+			w.Header().Set("X-sync-token", "t2")
+		}
+	}))
+	defer server.Close()
+
+	store, cleanup := inputtest.NewStore(t, "wss")
+	defer cleanup()
+
+	config := DefaultConfig
+	config.URL = server.URL
+	config.Username = "user"
+	config.Password = "pass"
+	var types []client.EventType
+	build := func(t client.EventType, fields common.MapStr) beat.Event {
+		types = append(types, t)
+		return beat.Event{Fields: fields}
+	}
+	c := NewCollector(config, store, build)
+	p := inputtest.NewOutlet(store)
+
+	now := time.Date(2020, 4, 8, 12, 0, 0, 0, time.UTC)
+	a.NoError(c.Collect(p, now))
+	a.Equal([]string{"none", "t1"}, tokens)
+	a.Equal([]client.EventType{client.WEB_SECURITY, client.WEB_SECURITY}, types)
+	if !a.Len(p.Events, 2) {
+		return
+	}
+
+	first := p.Events[0].Fields
+	a.Equal(EventType, first["event_type"])
+	a.Equal("2020-04-08T11:59:00Z", first["time"])
+	for key, expected := range map[string]interface{}{
+		"user_name":               "bob",
+		"url.text":                "https://www.example.com/index.html?q=1",
+		"url.port":                int64(443),
+		"http_request.user_agent": "Mozilla/5.0 (Windows NT 10.0)",
+		"connection.bytes_in":     int64(5120),
+		"wss.categories":          []string{"Technology/Internet", "Web Ads/Analytics"},
+		"wss.action":              "TCP_NC_MISS",
+	} {
+		value, err := first.GetValue(key)
+		a.NoError(err, key)
+		a.Equal(expected, value, key)
+	}
+
+	second := p.Events[1].Fields
+	text, _ := second.GetValue("url.text")
+	a.Equal("http://evil.example.com:8080/payload.exe", text)
+	threat, _ := second.GetValue("threat.name")
+	a.Equal("EICAR", threat)
+	_, err := second.GetValue("url.query")
+	a.Error(err)
+
+	var cp checkpoint
+	found, err := store.Get(checkpointKey, &cp)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("t2", cp.Token)
+	a.Equal(now.Add(-time.Hour).UnixNano()/int64(time.Millisecond), cp.StartDate)
+
+	// The first archive reaches the end of a run once, so the sync stops
+	// even though WSS has more.
+	tokens = nil
+	store.Delete(checkpointKey)
+	c = NewCollector(config, store, build)
+	end := time.Date(2020, 4, 8, 11, 59, 0, 0, time.UTC)
+	a.NoError(c.Collect(inputtest.NewOutlet(store), end))
+	a.Equal([]string{"none"}, tokens)
+	found, err = store.Get(checkpointKey, &cp)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("t1", cp.Token)
+}
+
+func TestParserUsesFieldsDirective(t *testing.T) {
+	p := &Parser{}
+	_, ok := p.Parse("#Fields: date time c-ip")
+	assert.False(t, ok)
+
+	fields, ok := p.Parse(`2020-04-08 11:59:00 10.0.0.5`)
+	assert.True(t, ok)
+	assert.Equal(t, "10.0.0.5", fields["device_ip"])
+
+	_, ok = p.Parse(`2020-04-08 11:59:00 10.0.0.5 extra`)
+	assert.False(t, ok)
+}
+
+func TestZipStream(t *testing.T) {
+	a := assert.New(t)
+
+	var buf bytes.Buffer
+	z := zip.NewWriter(&buf)
+	for _, name := range []string{"a.log", "b.log"} {
+		f, err := z.Create(name)
+		a.NoError(err)
+		f.Write([]byte(strings.Repeat(name, 1000)))
+	}
+	a.NoError(z.Close())
+
+	// A stored entry with its sizes in the local header, followed by no
+	// central directory.
+	content := []byte("stored")
+	var stored bytes.Buffer
+	for _, field := range []interface{}{
+		uint32(localHeaderSignature), uint16(10), uint16(0), uint16(methodStore),
+		uint32(0), crc32.ChecksumIEEE(content), uint32(len(content)), uint32(len(content)),
+		uint16(len("c.log")), uint16(0),
+	} {
+		binary.Write(&stored, binary.LittleEndian, field)
+	}
+	stored.WriteString("c.log")
+	stored.Write(content)
+
+	archive := newZipStream(io.MultiReader(bytes.NewReader(stored.Bytes()), bytes.NewReader(buf.Bytes())))
+	var names []string
+	for {
+		name, r, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if !a.NoError(err) {
+			return
+		}
+		data, err := ioutil.ReadAll(r)
+		a.NoError(err)
+		names = append(names, name)
+		if name == "c.log" {
+			a.Equal("stored", string(data))
+		} else {
+			a.Equal(strings.Repeat(name, 1000), string(data))
+		}
+	}
+	a.Equal([]string{"c.log", "a.log", "b.log"}, names)
+
+	// The CRC of the stored entry no longer matches.
+	corrupted := stored.Bytes()
+	corrupted[len(corrupted)-1] = 'x'
+	archive = newZipStream(bytes.NewReader(corrupted))
+	_, _, err := archive.Next()
+	a.NoError(err)
+	_, _, err = archive.Next()
+	a.Error(err)
+
+	name, _, err := newZipStream(bytes.NewReader(nil)).Next()
+	a.Equal(io.EOF, err)
+	a.Empty(name)
+}
+
+func TestValidateRanges(t *testing.T) {
+	config := DefaultConfig
+	config.Enabled = true
+	config.Username = "user"
+	config.Password = "pass"
+	assert.NoError(t, config.Validate())
+
+	for _, test := range []struct {
+		key    string
+		change func(c *Config)
+	}{
+		{"wss.period", func(c *Config) { c.Period = 0 }},
+		{"wss.period", func(c *Config) { c.Period = -time.Minute }},
+	} {
+		c := config
+		test.change(&c)
+		err := c.Validate()
+		if assert.Error(t, err, test.key) {
+			assert.Contains(t, err.Error(), test.key)
+		}
+	}
+}
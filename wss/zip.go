@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wss
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	localHeaderSignature    = 0x04034b50
+	dataDescriptorSignature = 0x08074b50
+	centralHeaderSignature  = 0x02014b50
+	endOfCentralSignature   = 0x06054b50
+
+	localHeaderLen = 30
+	zip64ExtraID   = 0x0001
+
+	methodStore   = 0
+	methodDeflate = 8
+
+	// flagDataDescriptor tells that the sizes and the CRC of an entry follow
+	// its data instead of being in its local header.
+	flagDataDescriptor = 0x8
+)
+
+// zipStream reads the entries of a zip archive as it is downloaded.
+// archive/zip needs the whole archive to read its central directory, which
+// is at the end, so zipStream walks the local file headers in front of the
+// entries instead. The entries are either deflated, whose data ends with the
+// deflate stream even when the sizes follow it in a data descriptor, or
+// stored with their sizes in the local header.
+type zipStream struct {
+	r     *bufio.Reader
+	entry *zipEntry
+}
+
+func newZipStream(r io.Reader) *zipStream {
+	return &zipStream{r: bufio.NewReader(r)}
+}
+
+// zipEntry reads the data of an entry and checks its CRC once it is read to
+// the end.
+type zipEntry struct {
+	name       string
+	flags      uint16
+	crc        uint32
+	zip64      bool
+	compressed io.Reader
+	data       io.Reader
+	hash       hash.Hash32
+}
+
+// Next returns the name and the data of the next entry, skipping what is
+// left of the current one. It returns io.EOF after the last entry, or for an
+// empty archive.
+func (z *zipStream) Next() (string, io.Reader, error) {
+	if z.entry != nil {
+		if err := z.finish(); err != nil {
+			return "", nil, err
+		}
+		z.entry = nil
+	}
+
+	var header [localHeaderLen]byte
+	n, err := io.ReadFull(z.r, header[:4])
+	if n == 0 && err == io.EOF {
+		return "", nil, io.EOF
+	}
+	if err != nil {
+		return "", nil, unexpectedEOF(err)
+	}
+	switch binary.LittleEndian.Uint32(header[:4]) {
+	case localHeaderSignature:
+	case centralHeaderSignature, endOfCentralSignature:
+		return "", nil, io.EOF
+	default:
+		return "", nil, errors.New("invalid zip local file header")
+	}
+	if _, err := io.ReadFull(z.r, header[4:]); err != nil {
+		return "", nil, unexpectedEOF(err)
+	}
+
+	e := &zipEntry{
+		flags: binary.LittleEndian.Uint16(header[6:]),
+		crc:   binary.LittleEndian.Uint32(header[14:]),
+		hash:  crc32.NewIEEE(),
+	}
+	method := binary.LittleEndian.Uint16(header[8:])
+	size := uint64(binary.LittleEndian.Uint32(header[18:]))
+	nameLen := int(binary.LittleEndian.Uint16(header[26:]))
+	extraLen := int(binary.LittleEndian.Uint16(header[28:]))
+
+	buf := make([]byte, nameLen+extraLen)
+	if _, err := io.ReadFull(z.r, buf); err != nil {
+		return "", nil, unexpectedEOF(err)
+	}
+	e.name = string(buf[:nameLen])
+	for extra := buf[nameLen:]; len(extra) >= 4; {
+		id := binary.LittleEndian.Uint16(extra)
+		fieldLen := int(binary.LittleEndian.Uint16(extra[2:]))
+		extra = extra[4:]
+		if fieldLen > len(extra) {
+			break
+		}
+		if id == zip64ExtraID {
+			e.zip64 = true
+			// The uncompressed size comes first, then the compressed size.
+			if size == 0xffffffff && fieldLen >= 16 {
+				size = binary.LittleEndian.Uint64(extra[8:])
+			}
+		}
+		extra = extra[fieldLen:]
+	}
+
+	sized := e.flags&flagDataDescriptor == 0
+	switch {
+	case method == methodDeflate && sized:
+		e.compressed = io.LimitReader(z.r, int64(size))
+		e.data = flate.NewReader(e.compressed)
+	case method == methodDeflate:
+		// The bufio.Reader is an io.ByteReader, so flate does not read
+		// past the end of the deflate stream.
+		e.data = flate.NewReader(z.r)
+	case method == methodStore && sized:
+		e.compressed = io.LimitReader(z.r, int64(size))
+		e.data = e.compressed
+	case method == methodStore:
+		return "", nil, fmt.Errorf("stored zip entry %s without sizes cannot be streamed", e.name)
+	default:
+		return "", nil, fmt.Errorf("unsupported compression method %d of zip entry %s", method, e.name)
+	}
+	e.data = io.TeeReader(e.data, e.hash)
+
+	z.entry = e
+	return e.name, e.data, nil
+}
+
+// finish reads what is left of the current entry and its data descriptor,
+// and checks its CRC.
+func (z *zipStream) finish() error {
+	e := z.entry
+	if _, err := io.Copy(ioutil.Discard, e.data); err != nil {
+		return fmt.Errorf("error reading zip entry %s: %v", e.name, err)
+	}
+	if e.compressed != nil {
+		// The deflate stream may end before the compressed size.
+		if _, err := io.Copy(ioutil.Discard, e.compressed); err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+
+	if e.flags&flagDataDescriptor != 0 {
+		// The signature of the data descriptor is optional.
+		var descriptor [24]byte
+		if _, err := io.ReadFull(z.r, descriptor[:4]); err != nil {
+			return unexpectedEOF(err)
+		}
+		crc := descriptor[:4]
+		if binary.LittleEndian.Uint32(descriptor[:4]) == dataDescriptorSignature {
+			if _, err := io.ReadFull(z.r, descriptor[4:8]); err != nil {
+				return unexpectedEOF(err)
+			}
+			crc = descriptor[4:8]
+		}
+		e.crc = binary.LittleEndian.Uint32(crc)
+
+		sizesLen := 8
+		if e.zip64 {
+			sizesLen = 16
+		}
+		if _, err := io.ReadFull(z.r, descriptor[8:8+sizesLen]); err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+
+	if e.crc != 0 && e.hash.Sum32() != e.crc {
+		return fmt.Errorf("checksum error in zip entry %s", e.name)
+	}
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}